@@ -0,0 +1,192 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+const acmeChallengeLabel = "_acme-challenge"
+
+var acmeWait time.Duration
+
+var acmeCmd = &cobra.Command{
+	Use:     "acme",
+	Short:   "ACME DNS-01 challenge hooks for certbot and lego",
+	GroupID: GroupManagement,
+	Long: `Creates and removes the _acme-challenge TXT records used by the ACME DNS-01 challenge, so steamer can act as a certbot manual hook or a lego exec provider.
+
+The hostname and token can be passed as arguments (lego's "present <fqdn> <value>" order) or, when omitted, are read from certbot's CERTBOT_DOMAIN and CERTBOT_VALIDATION environment variables. The registered domain is found among your Porkbun domains, so deep subdomains like a.b.aaie.cloud work.`,
+	Example: `  # certbot
+  certbot certonly --manual --preferred-challenges dns \
+    --manual-auth-hook "steamer acme present --wait 60s" \
+    --manual-cleanup-hook "steamer acme cleanup" -d aaie.cloud
+
+  # lego exec provider
+  EXEC_PATH=/path/to/hook.sh lego --dns exec -d aaie.cloud run
+  # where hook.sh runs: steamer acme "$1" "$2" "$3"`,
+}
+
+var acmePresentCmd = &cobra.Command{
+	Use:   "present [fqdn] [token]",
+	Short: "Create the _acme-challenge TXT record",
+	Args:  cobra.RangeArgs(0, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client, domain, name, token := acmeSetup(ctx, args)
+
+		records, err := client.RetrieveRecordsContext(ctx, domain)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error retrieving records for %s: %v", domain, err)))
+			os.Exit(exitCode(err))
+		}
+		if len(acmeChallengeRecords(records, domain, name, token)) > 0 {
+			fmt.Println(theme.Muted.Render(fmt.Sprintf("Challenge record for %s already present", recordLabel(name, domain))))
+			return
+		}
+
+		id, err := client.CreateRecordContext(ctx, domain, porkbun.CreateRecordRequest{
+			Name:    name,
+			Type:    "TXT",
+			Content: token,
+		})
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error creating challenge record: %v", err)))
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Created challenge record for %s (ID: %s)", recordLabel(name, domain), id)))
+
+		if acmeWait > 0 {
+			fmt.Println(theme.Warn.Render(fmt.Sprintf("⏳ Waiting %s for DNS propagation...", acmeWait)))
+			select {
+			case <-time.After(acmeWait):
+			case <-ctx.Done():
+			}
+		}
+	},
+}
+
+var acmeCleanupCmd = &cobra.Command{
+	Use:   "cleanup [fqdn] [token]",
+	Short: "Remove the _acme-challenge TXT record",
+	Args:  cobra.RangeArgs(0, 2),
+	Run: func(cmd *cobra.Command, args []string) {
+		ctx := cmd.Context()
+		client, domain, name, token := acmeSetup(ctx, args)
+
+		records, err := client.RetrieveRecordsContext(ctx, domain)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error retrieving records for %s: %v", domain, err)))
+			os.Exit(exitCode(err))
+		}
+
+		matches := acmeChallengeRecords(records, domain, name, token)
+		if len(matches) == 0 {
+			fmt.Println(theme.Muted.Render(fmt.Sprintf("No challenge record for %s to remove", recordLabel(name, domain))))
+			return
+		}
+		for _, r := range matches {
+			id := r.ID
+			if err := client.DeleteRecordContext(ctx, domain, id); err != nil {
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("Error deleting challenge record %s: %v", id, err)))
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("Removed challenge record for %s (ID: %s)", recordLabel(name, domain), id)))
+		}
+	},
+}
+
+// acmeSetup resolves the challenge hostname and token from the arguments or
+// certbot's environment, and finds the Porkbun domain the hostname belongs
+// to. It returns the challenge record name relative to that domain.
+func acmeSetup(ctx context.Context, args []string) (*porkbun.Client, string, string, string) {
+	fqdn := os.Getenv("CERTBOT_DOMAIN")
+	token := os.Getenv("CERTBOT_VALIDATION")
+	if len(args) > 0 {
+		fqdn = args[0]
+	}
+	if len(args) > 1 {
+		token = args[1]
+	}
+	if fqdn == "" || token == "" {
+		fmt.Println(theme.Fail.Render("Missing hostname or token: pass them as arguments or set CERTBOT_DOMAIN and CERTBOT_VALIDATION"))
+		os.Exit(1)
+	}
+
+	fqdn = strings.TrimSuffix(strings.ToLower(fqdn), ".")
+	fqdn = strings.TrimPrefix(fqdn, "*.")
+	if !strings.HasPrefix(fqdn, acmeChallengeLabel+".") {
+		fqdn = acmeChallengeLabel + "." + fqdn
+	}
+
+	client, err := newClient(ctx)
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitCode(err))
+	}
+
+	domains, err := client.ListDomainsContext(ctx)
+	if err != nil {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("Error listing domains: %v", err)))
+		os.Exit(exitCode(err))
+	}
+	domain := findZone(fqdn, domains)
+	if domain == "" {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("No domain in your Porkbun account contains %s", fqdn)))
+		os.Exit(ExitNotFound)
+	}
+
+	return client, domain, porkbun.Subdomain(fqdn, domain), token
+}
+
+// findZone returns the longest registered domain that fqdn falls under, or
+// "" if none does.
+func findZone(fqdn string, domains []porkbun.Domain) string {
+	best := ""
+	for _, d := range domains {
+		name := strings.ToLower(d.Domain)
+		if (fqdn == name || strings.HasSuffix(fqdn, "."+name)) && len(name) > len(best) {
+			best = name
+		}
+	}
+	return best
+}
+
+// acmeChallengeRecords returns the TXT records at name holding token.
+func acmeChallengeRecords(records []porkbun.DNSRecord, domain, name, token string) []porkbun.DNSRecord {
+	var matches []porkbun.DNSRecord
+	for _, r := range records {
+		if r.Type == "TXT" && porkbun.Subdomain(r.Name, domain) == name && strings.Trim(r.Content, `"`) == token {
+			matches = append(matches, r)
+		}
+	}
+	return matches
+}
+
+func init() {
+	acmePresentCmd.Flags().DurationVar(&acmeWait, "wait", 0, "Time to wait after creating the record so it can propagate")
+	acmeCmd.AddCommand(acmePresentCmd)
+	acmeCmd.AddCommand(acmeCleanupCmd)
+	rootCmd.AddCommand(acmeCmd)
+}
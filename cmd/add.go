@@ -0,0 +1,245 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	addTTL    int
+	addPrio   int
+	addNotes  string
+	addUpsert bool
+	addJSON   bool
+)
+
+var addCmd = &cobra.Command{
+	Use:     "add [domain] [type] [name] [content]",
+	Short:   "Add a DNS record of any supported type",
+	GroupID: GroupManagement,
+	Long: `Creates a DNS record of any type Porkbun supports: ` + strings.Join(porkbun.RecordTypes, ", ") + `. Use "" or @ for the root domain.
+
+The content is checked for the record type before anything is sent to Porkbun. MX and SRV priorities are given with --prio, so MX content is just the mail server and SRV content is "weight port target".
+
+With --upsert (or --ensure), existing records with the same name and type are looked up first: nothing happens if one already has this content, a single record with different content is edited in place, and several conflicting records are reported as an error. This makes the add commands safe to re-run from provisioning scripts.`,
+	Example: `  # Add an MX record with priority 10
+  steamer add aaie.cloud MX "" mail.aaie.cloud --prio 10
+
+  # Add an SRV record
+  steamer add aaie.cloud SRV _sip._tcp "5 5060 sip.aaie.cloud" --prio 10
+
+  # Restrict certificate issuance to Let's Encrypt
+  steamer add aaie.cloud CAA @ '0 issue "letsencrypt.org"'
+
+  # Make sure www has this address, whatever it pointed to before
+  steamer add aaie.cloud A www 1.2.3.4 --upsert --json`,
+	Args: cobra.ExactArgs(4),
+	Run: func(cmd *cobra.Command, args []string) {
+		runAdd(cmd, args[0], strings.ToUpper(args[1]), args[2], args[3])
+	},
+}
+
+// addResult describes what an add command did.
+type addResult struct {
+	// Action is "create", "update" or "noop".
+	Action  string `json:"action"`
+	ID      string `json:"id"`
+	Domain  string `json:"domain"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	// Previous is the content the record had before an update.
+	Previous string `json:"previous,omitempty"`
+}
+
+// upsertConflict is returned by ensureRecord when several records already
+// exist and none of them has the wanted content.
+type upsertConflict struct {
+	records []porkbun.DNSRecord
+}
+
+func (e *upsertConflict) Error() string {
+	return fmt.Sprintf("%d %s records already exist with different content; refusing to guess which one to update", len(e.records), e.records[0].Type)
+}
+
+// runAdd creates a record, or with --upsert makes sure it exists, and
+// reports what happened. It backs add and all of the add-* commands.
+func runAdd(cmd *cobra.Command, domain, recordType, name, content string) {
+	if name == "@" {
+		name = ""
+	}
+	name = porkbun.Subdomain(name, domain)
+
+	if err := porkbun.ValidateContent(recordType, content); err != nil {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("Invalid record: %v", err)))
+		os.Exit(exitCode(err))
+	}
+	if cmd.Flags().Changed("ttl") {
+		if err := porkbun.ValidateTTL(addTTL); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Invalid record: %v", err)))
+			os.Exit(exitCode(err))
+		}
+	}
+
+	client, err := newClient(cmd.Context())
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitCode(err))
+	}
+
+	req := porkbun.CreateRecordRequest{
+		Name:    name,
+		Type:    recordType,
+		Content: content,
+		Notes:   addNotes,
+	}
+	if cmd.Flags().Changed("ttl") {
+		req.TTL = strconv.Itoa(addTTL)
+	}
+	if cmd.Flags().Changed("prio") {
+		req.Prio = strconv.Itoa(addPrio)
+	}
+
+	var res addResult
+	if addUpsert {
+		res, err = ensureRecord(cmd, client, domain, req)
+	} else {
+		var id string
+		id, err = client.CreateRecordContext(cmd.Context(), domain, req)
+		res = addResult{Action: "create", ID: id}
+	}
+	var conflict *upsertConflict
+	if errors.As(err, &conflict) {
+		printRecordTable(conflict.records)
+		fmt.Println()
+		fmt.Println(theme.Fail.Render(err.Error()))
+		os.Exit(ExitInvalid)
+	}
+	if err != nil {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("Error creating %s record: %v", recordType, err)))
+		os.Exit(exitCode(err))
+	}
+	res.Domain = domain
+	res.Name = recordLabel(name, domain)
+	res.Type = recordType
+	res.Content = content
+
+	if addJSON {
+		b, err := json.MarshalIndent(res, "", "  ")
+		if err != nil {
+			fmt.Printf("Error encoding JSON: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(string(b))
+		return
+	}
+	switch res.Action {
+	case "create":
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully created %s record for %s with content %s (ID: %s)", recordType, res.Name, content, res.ID)))
+	case "update":
+		if res.Previous != "" {
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("Updated %s record for %s: %s -> %s (ID: %s)", recordType, res.Name, res.Previous, content, res.ID)))
+		} else {
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("Updated settings of %s record for %s with content %s (ID: %s)", recordType, res.Name, content, res.ID)))
+		}
+	case "noop":
+		fmt.Println(theme.Muted.Render(fmt.Sprintf("%s record for %s already has content %s (ID: %s)", recordType, res.Name, content, res.ID)))
+	}
+}
+
+// ensureRecord makes sure a record like req exists, creating it, editing a
+// single existing record of the same name and type, or doing nothing.
+// TTL, priority and notes are only brought in line when they were given.
+func ensureRecord(cmd *cobra.Command, client *porkbun.Client, domain string, req porkbun.CreateRecordRequest) (addResult, error) {
+	ctx := cmd.Context()
+	existing, err := client.RetrieveRecordsByNameTypeContext(ctx, domain, req.Type, req.Name)
+	if err != nil {
+		return addResult{}, err
+	}
+
+	target := -1
+	for i, r := range existing {
+		if porkbun.SameContent(req.Type, r.Content, req.Content) {
+			target = i
+			break
+		}
+	}
+	switch {
+	case target < 0 && len(existing) == 0:
+		id, err := client.CreateRecordContext(ctx, domain, req)
+		return addResult{Action: "create", ID: id}, err
+	case target < 0 && len(existing) > 1:
+		return addResult{}, &upsertConflict{records: existing}
+	case target < 0:
+		target = 0
+	}
+
+	current := existing[target]
+	ttl, prio := current.TTL, current.Prio
+	if cmd.Flags().Changed("ttl") {
+		ttl = addTTL
+	}
+	if cmd.Flags().Changed("prio") {
+		prio = addPrio
+	}
+	edit := porkbun.EditRecordRequest{
+		Name:    req.Name,
+		Type:    req.Type,
+		Content: req.Content,
+		TTL:     strconv.Itoa(ttl),
+		Prio:    strconv.Itoa(prio),
+	}
+	if cmd.Flags().Changed("notes") {
+		edit.Notes = &req.Notes
+	}
+
+	res := addResult{ID: current.ID}
+	contentChanged := !porkbun.SameContent(req.Type, current.Content, req.Content)
+	if contentChanged {
+		res.Previous = current.Content
+	}
+	if !contentChanged && ttl == current.TTL && prio == current.Prio && (edit.Notes == nil || *edit.Notes == current.Notes) {
+		res.Action = "noop"
+		return res, nil
+	}
+	res.Action = "update"
+	return res, client.EditRecordContext(ctx, domain, res.ID, edit)
+}
+
+// addRecordFlags registers the flags shared by add and the add-* commands.
+func addRecordFlags(cmd *cobra.Command) {
+	cmd.Flags().IntVar(&addTTL, "ttl", porkbun.MinTTL, "Time to live in seconds (minimum 600)")
+	cmd.Flags().IntVar(&addPrio, "prio", 0, "Priority for MX, SRV and similar records")
+	cmd.Flags().StringVar(&addNotes, "notes", "", "Notes to attach to the record")
+	cmd.Flags().BoolVar(&addUpsert, "upsert", false, "Update an existing record with the same name and type instead of adding a duplicate")
+	cmd.Flags().BoolVar(&addUpsert, "ensure", false, "Alias for --upsert")
+	cmd.Flags().BoolVar(&addJSON, "json", false, "Output the result (create, update or noop) as JSON")
+}
+
+func init() {
+	addRecordFlags(addCmd)
+	rootCmd.AddCommand(addCmd)
+}
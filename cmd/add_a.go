@@ -15,12 +15,6 @@
 package cmd
 
 import (
-	"fmt"
-	"os"
-
-	"github.com/ghchinoy/steamer/internal/porkbun"
-	"github.com/ghchinoy/steamer/internal/theme"
-
 	"github.com/spf13/cobra"
 )
 
@@ -33,34 +27,17 @@ var addACmd = &cobra.Command{
   steamer add-a aaie.cloud www 192.168.1.1
 
   # Add an A record for the root domain (aaie.cloud)
-  steamer add-a aaie.cloud "" 192.168.1.1`,
+  steamer add-a aaie.cloud "" 192.168.1.1
+
+  # Point www at 192.168.1.2, updating the existing A record if there is one
+  steamer add-a aaie.cloud www 192.168.1.2 --upsert`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, secretKey, err := getClientConfig()
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
-		}
-
-		domain := args[0]
-		subdomain := args[1]
-		ip := args[2]
-
-		client := porkbun.NewClient(apiKey, secretKey)
-		id, err := client.CreateRecord(domain, porkbun.CreateRecordRequest{
-			Name:    subdomain,
-			Type:    "A",
-			Content: ip,
-		})
-		if err != nil {
-			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error creating A record: %v", err)))
-			os.Exit(1)
-		}
-
-		fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully created A record for %s.%s pointing to %s (ID: %s)", subdomain, domain, ip, id)))
+		runAdd(cmd, args[0], "A", args[1], args[2])
 	},
 }
 
 func init() {
+	addRecordFlags(addACmd)
 	rootCmd.AddCommand(addACmd)
 }
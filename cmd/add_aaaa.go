@@ -15,12 +15,6 @@
 package cmd
 
 import (
-	"fmt"
-	"os"
-
-	"github.com/ghchinoy/steamer/internal/porkbun"
-	"github.com/ghchinoy/steamer/internal/theme"
-
 	"github.com/spf13/cobra"
 )
 
@@ -36,31 +30,11 @@ var addAaaaCmd = &cobra.Command{
   steamer add-aaaa aaie.cloud "" 2001:db8::1`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, secretKey, err := getClientConfig()
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
-		}
-
-		domain := args[0]
-		subdomain := args[1]
-		ip := args[2]
-
-		client := porkbun.NewClient(apiKey, secretKey)
-		id, err := client.CreateRecord(domain, porkbun.CreateRecordRequest{
-			Name:    subdomain,
-			Type:    "AAAA",
-			Content: ip,
-		})
-		if err != nil {
-			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error creating AAAA record: %v", err)))
-			os.Exit(1)
-		}
-
-		fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully created AAAA record for %s.%s pointing to %s (ID: %s)", subdomain, domain, ip, id)))
+		runAdd(cmd, args[0], "AAAA", args[1], args[2])
 	},
 }
 
 func init() {
+	addRecordFlags(addAaaaCmd)
 	rootCmd.AddCommand(addAaaaCmd)
 }
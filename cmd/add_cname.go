@@ -15,12 +15,6 @@
 package cmd
 
 import (
-	"fmt"
-	"os"
-
-	"github.com/ghchinoy/steamer/internal/porkbun"
-	"github.com/ghchinoy/steamer/internal/theme"
-
 	"github.com/spf13/cobra"
 )
 
@@ -33,31 +27,11 @@ var addCnameCmd = &cobra.Command{
   steamer add-cname aaie.cloud blog ghs.google.com`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, secretKey, err := getClientConfig()
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
-		}
-
-		domain := args[0]
-		subdomain := args[1]
-		target := args[2]
-
-		client := porkbun.NewClient(apiKey, secretKey)
-		id, err := client.CreateRecord(domain, porkbun.CreateRecordRequest{
-			Name:    subdomain,
-			Type:    "CNAME",
-			Content: target,
-		})
-		if err != nil {
-			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error creating CNAME record: %v", err)))
-			os.Exit(1)
-		}
-
-		fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully created CNAME record for %s.%s pointing to %s (ID: %s)", subdomain, domain, target, id)))
+		runAdd(cmd, args[0], "CNAME", args[1], args[2])
 	},
 }
 
 func init() {
+	addRecordFlags(addCnameCmd)
 	rootCmd.AddCommand(addCnameCmd)
 }
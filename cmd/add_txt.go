@@ -15,12 +15,6 @@
 package cmd
 
 import (
-	"fmt"
-	"os"
-
-	"github.com/ghchinoy/steamer/internal/porkbun"
-	"github.com/ghchinoy/steamer/internal/theme"
-
 	"github.com/spf13/cobra"
 )
 
@@ -33,34 +27,17 @@ var addTxtCmd = &cobra.Command{
   steamer add-txt aaie.cloud _dmarc "v=DMARC1; p=none;"
 
   # Add a Google site verification record to the root domain
-  steamer add-txt aaie.cloud "" "google-site-verification=abc123xyz"`,
+  steamer add-txt aaie.cloud "" "google-site-verification=abc123xyz"
+
+  # Add an SPF record with a longer TTL and a note on why it exists
+  steamer add-txt aaie.cloud "" "v=spf1 include:_spf.google.com ~all" --ttl 3600 --notes "Google Workspace"`,
 	Args: cobra.ExactArgs(3),
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, secretKey, err := getClientConfig()
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
-		}
-
-		domain := args[0]
-		subdomain := args[1]
-		text := args[2]
-
-		client := porkbun.NewClient(apiKey, secretKey)
-		id, err := client.CreateRecord(domain, porkbun.CreateRecordRequest{
-			Name:    subdomain,
-			Type:    "TXT",
-			Content: text,
-		})
-		if err != nil {
-			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error creating TXT record: %v", err)))
-			os.Exit(1)
-		}
-
-		fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully created TXT record for %s.%s with value %s (ID: %s)", subdomain, domain, text, id)))
+		runAdd(cmd, args[0], "TXT", args[1], args[2])
 	},
 }
 
 func init() {
+	addRecordFlags(addTxtCmd)
 	rootCmd.AddCommand(addTxtCmd)
 }
@@ -0,0 +1,143 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/ghchinoy/steamer/internal/plan"
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	applyPrune       bool
+	applyAutoApprove bool
+)
+
+var applyCmd = &cobra.Command{
+	Use:     "apply [zone-file]",
+	Short:   "Change live DNS records to match a zone file",
+	GroupID: GroupManagement,
+	Long: `Computes the same plan as 'plan', prints it, and after confirmation creates, updates and deletes records at Porkbun so they match the zone file.
+
+Records that exist at Porkbun but not in the file are left alone unless --prune is passed.`,
+	Example: `  # Review and apply a zone file
+  steamer apply zones/aaie.cloud.yaml
+
+  # Apply without prompting, removing undeclared records (for CI)
+  steamer apply zones/aaie.cloud.yaml --prune --auto-approve`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		zone, changes, err := computePlan(cmd.Context(), client, args[0], applyPrune)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(err.Error()))
+			os.Exit(exitCode(err))
+		}
+
+		printPlan(zone.Domain, changes)
+		if len(changes) == 0 {
+			return
+		}
+
+		fmt.Println()
+		if !applyAutoApprove && !confirm("Apply these changes?") {
+			fmt.Println(theme.Warn.Render("Apply cancelled."))
+			return
+		}
+
+		failed := 0
+		// Deletes go first so that a replacement CNAME doesn't collide with
+		// the record it replaces.
+		for _, action := range []plan.Action{plan.Delete, plan.Update, plan.Create} {
+			for _, c := range changes {
+				if c.Action != action {
+					continue
+				}
+				if err := applyChange(cmd.Context(), client, zone.Domain, c); err != nil {
+					failed++
+					fmt.Println(theme.Fail.Render(fmt.Sprintf("Error: %s %s %s: %v", c.Action, c.Type, recordLabel(c.Name, zone.Domain), err)))
+					continue
+				}
+				fmt.Println(theme.Pass.Render(fmt.Sprintf("%s %s %s", c.Action, c.Type, recordLabel(c.Name, zone.Domain))))
+			}
+		}
+
+		if failed > 0 {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("\n%d of %d changes failed.", failed, len(changes))))
+			os.Exit(1)
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("\nApplied %d changes to %s.", len(changes), zone.Domain)))
+	},
+}
+
+func applyChange(ctx context.Context, client *porkbun.Client, domain string, c plan.Change) error {
+	switch c.Action {
+	case plan.Create:
+		_, err := client.CreateRecordContext(ctx, domain, porkbun.CreateRecordRequest{
+			Name:    c.Name,
+			Type:    c.Type,
+			Content: c.Desired.Content,
+			TTL:     strconv.Itoa(c.Desired.TTL),
+			Prio:    strconv.Itoa(c.Desired.Prio),
+			Notes:   notesValue(c.Desired.Notes),
+		})
+		return err
+	case plan.Update:
+		return client.EditRecordContext(ctx, domain, c.Current.ID, porkbun.EditRecordRequest{
+			Name:    c.Name,
+			Type:    c.Type,
+			Content: c.Desired.Content,
+			TTL:     strconv.Itoa(c.Desired.TTL),
+			Prio:    strconv.Itoa(c.Desired.Prio),
+			Notes:   c.Desired.Notes,
+		})
+	case plan.Delete:
+		return client.DeleteRecordContext(ctx, domain, c.Current.ID)
+	}
+	return fmt.Errorf("unknown action %v", c.Action)
+}
+
+// recordLabel renders a relative record name as a fully-qualified one.
+func recordLabel(name, domain string) string {
+	if name == "" {
+		return domain
+	}
+	return name + "." + domain
+}
+
+func notesValue(notes *string) string {
+	if notes == nil {
+		return ""
+	}
+	return *notes
+}
+
+func init() {
+	applyCmd.Flags().BoolVar(&applyPrune, "prune", false, "Delete live records that are not declared in the zone file")
+	applyCmd.Flags().BoolVar(&applyAutoApprove, "auto-approve", false, "Apply the plan without asking for confirmation")
+	rootCmd.AddCommand(applyCmd)
+}
@@ -0,0 +1,172 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/credentials"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+var (
+	authStore      string
+	authSkipVerify bool
+)
+
+var authCmd = &cobra.Command{
+	Use:     "auth",
+	Short:   "Store Porkbun API keys outside the config file",
+	GroupID: GroupConfig,
+	Long: `Keeps API keys out of the plaintext config file. 'auth login' stores the keys of the active profile (see 'steamer profile') in the OS keyring or in a passphrase-encrypted file next to config.yaml, and records which one in the profile's store setting. 'auth logout' removes them again.
+
+Keys can also come from any command that prints them, such as a password manager's CLI, with the apikey_command and secret_command settings. Only the first line of the output is used, as with pass:
+
+  apikey_command: pass show porkbun/apikey
+  secret_command: pass show porkbun/secret
+
+Keys written directly in the config file or environment take precedence over commands, and commands over stores.`,
+	Example: `  # Store the keys in the OS keyring (Secret Service, Keychain, ...)
+  steamer auth login
+
+  # Store a client account's keys in the encrypted file instead
+  steamer auth login --profile acme-corp --store file
+
+  # Forget the stored keys
+  steamer auth logout --profile acme-corp`,
+}
+
+var authLoginCmd = &cobra.Command{
+	Use:   "login",
+	Short: "Store API keys for the active profile",
+	Long:  `Asks for the API key and secret (without echoing them), checks them against Porkbun, and stores them in the OS keyring or the encrypted credentials file. When stdin is not a terminal, the key and secret are read from its first two lines.`,
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		profile := activeProfile()
+		if strings.Contains(profile, ".") {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Profile names must not contain dots: %q", profile)))
+			os.Exit(ExitInvalid)
+		}
+		store, err := credentialStore(authStore)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(err.Error()))
+			os.Exit(ExitInvalid)
+		}
+
+		apiKey, err := readSecret("Porkbun API key: ")
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error reading API key: %v", err)))
+			os.Exit(1)
+		}
+		secretKey, err := readSecret("Porkbun secret API key: ")
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error reading secret API key: %v", err)))
+			os.Exit(1)
+		}
+		if apiKey == "" || secretKey == "" {
+			fmt.Println(theme.Fail.Render("Both the API key and the secret API key are required"))
+			os.Exit(ExitInvalid)
+		}
+
+		if !authSkipVerify {
+			if _, err := clientWithKeys(apiKey, secretKey).PingContext(cmd.Context()); err != nil {
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("Porkbun did not accept these keys: %v", err)))
+				os.Exit(exitCode(err))
+			}
+		}
+
+		storeCredentials(profile, store, apiKey, secretKey)
+	},
+}
+
+var authLogoutCmd = &cobra.Command{
+	Use:   "logout",
+	Short: "Remove the stored API keys of the active profile",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		profile := activeProfile()
+		name := authStore
+		if !cmd.Flags().Changed("store") {
+			name = viper.GetString(profileSettingKey(profile, "store"))
+		}
+		if name == "" {
+			fmt.Println(theme.Muted.Render(fmt.Sprintf("Profile %s has no stored credentials", profile)))
+			return
+		}
+		store, err := credentialStore(name)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(err.Error()))
+			os.Exit(ExitInvalid)
+		}
+
+		err = store.Delete(profile)
+		if err != nil && !errors.Is(err, credentials.ErrNotFound) {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error removing credentials: %v", err)))
+			os.Exit(1)
+		}
+		if path, perr := configFilePath(); perr == nil {
+			if perr := deleteConfigValue(path, append(profileConfigPath(profile), "store")...); perr != nil {
+				fmt.Println(theme.Warn.Render(fmt.Sprintf("⚠️  Could not update %s: %v", path, perr)))
+			}
+		}
+		if errors.Is(err, credentials.ErrNotFound) {
+			fmt.Println(theme.Muted.Render(fmt.Sprintf("No credentials for profile %s were in the %s store", profile, store.Name())))
+			return
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Removed credentials for profile %s from the %s store", profile, store.Name())))
+	},
+}
+
+// storeCredentials saves the keys of profile in store and records the
+// store in the profile's config, exiting on failure.
+func storeCredentials(profile string, store credentials.Store, apiKey, secretKey string) {
+	if err := store.Set(profile, credentials.Credentials{APIKey: apiKey, SecretAPIKey: secretKey}); err != nil {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("Error storing credentials: %v", err)))
+		if store.Name() == credentials.KeyringStore {
+			fmt.Println(theme.Muted.Render("Where no keyring service is running (servers, containers), use --store file."))
+		}
+		os.Exit(1)
+	}
+	path, err := configFilePath()
+	if err == nil {
+		err = setConfigValue(path, store.Name(), append(profileConfigPath(profile), "store")...)
+	}
+	if err != nil {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("Stored the keys, but could not record the store in the config file: %v", err)))
+		os.Exit(1)
+	}
+	fmt.Println(theme.Pass.Render(fmt.Sprintf("Stored credentials for profile %s in the %s store", profile, store.Name())))
+
+	if apiKey, secretKey := credentialsFrom(func(key string) string {
+		return viper.GetString(profileSettingKey(profile, key))
+	}); apiKey != "" || secretKey != "" {
+		fmt.Println(theme.Warn.Render(fmt.Sprintf("⚠️  Keys for profile %s are also set in %s or the environment and take precedence; remove them to use the stored ones.", profile, path)))
+	}
+}
+
+func init() {
+	authLoginCmd.Flags().StringVar(&authStore, "store", credentials.KeyringStore, "Where to store the keys: keyring or file")
+	authLoginCmd.Flags().BoolVar(&authSkipVerify, "skip-verify", false, "Store the keys without checking them against Porkbun")
+	authLogoutCmd.Flags().StringVar(&authStore, "store", credentials.KeyringStore, "Store to remove the keys from (default: the profile's store setting)")
+	authCmd.AddCommand(authLoginCmd)
+	authCmd.AddCommand(authLogoutCmd)
+	rootCmd.AddCommand(authCmd)
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	configInitStore string
+	configInitForce bool
+)
+
+var configCmd = &cobra.Command{
+	Use:     "config",
+	Short:   "Set up steamer's configuration",
+	GroupID: GroupConfig,
+}
+
+var configInitCmd = &cobra.Command{
+	Use:   "init",
+	Short: "Write the config file interactively",
+	Long: `Asks for a Porkbun API key and secret (without echoing them), checks them against Porkbun, and only then saves them for the active profile: in the config file by default, or in the OS keyring or encrypted credentials file with --store. The rest of the config file is left as it is.
+
+Create the keys at https://porkbun.com/account/api, and enable API access for each domain you want to manage under Domain Management.`,
+	Example: `  # First-time setup
+  steamer config init
+
+  # Add a second account as the acme-corp profile, keeping its keys in the keyring
+  steamer config init --profile acme-corp --store keyring`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		profile := activeProfile()
+		path, err := configFilePath()
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error locating config file: %v", err)))
+			os.Exit(1)
+		}
+
+		if configInitStore != "config" {
+			if _, err := credentialStore(configInitStore); err != nil {
+				fmt.Println(theme.Fail.Render(err.Error()))
+				os.Exit(ExitInvalid)
+			}
+		}
+
+		fmt.Printf("Setting up profile %s in %s\n", theme.Accent.Render(profile), path)
+		fmt.Println(theme.Muted.Render("Create API keys at https://porkbun.com/account/api"))
+		fmt.Println()
+
+		if rc, err := resolveCredentials(cmd.Context(), profile, false); err == nil && !configInitForce {
+			if !confirm(fmt.Sprintf("Profile %s already has keys (from %s). Replace them?", profile, rc.APIKeySource)) {
+				fmt.Println(theme.Muted.Render("Config not changed."))
+				return
+			}
+		}
+
+		apiKey, err := readSecret("Porkbun API key: ")
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error reading API key: %v", err)))
+			os.Exit(1)
+		}
+		secretKey, err := readSecret("Porkbun secret API key: ")
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error reading secret API key: %v", err)))
+			os.Exit(1)
+		}
+		if apiKey == "" || secretKey == "" {
+			fmt.Println(theme.Fail.Render("Both the API key and the secret API key are required"))
+			os.Exit(ExitInvalid)
+		}
+
+		ping, err := clientWithKeys(apiKey, secretKey).PingContext(cmd.Context())
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Porkbun did not accept these keys: %v", err)))
+			fmt.Println(theme.Muted.Render("Nothing was written."))
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Porkbun accepted the keys (your IP: %s)", ping.YourIP)))
+
+		if configInitStore == "config" {
+			keyPath := profileConfigPath(profile)
+			err := setConfigValue(path, apiKey, append(keyPath, "apikey")...)
+			if err == nil {
+				err = setConfigValue(path, secretKey, append(keyPath, "secretapikey")...)
+			}
+			if err == nil {
+				err = deleteConfigValue(path, append(keyPath, "store")...)
+			}
+			if err != nil {
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("Error writing %s: %v", path, err)))
+				os.Exit(1)
+			}
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("Saved the keys for profile %s to %s", profile, path)))
+		} else {
+			store, _ := credentialStore(configInitStore)
+			storeCredentials(profile, store, apiKey, secretKey)
+		}
+
+		fmt.Println()
+		fmt.Println("Next: " + theme.Accent.Render("steamer list-domains") + theme.Muted.Render("   (run 'steamer doctor' if anything looks wrong)"))
+	},
+}
+
+func init() {
+	configInitCmd.Flags().StringVar(&configInitStore, "store", "config", "Where to save the keys: config (the config file), keyring or file")
+	configInitCmd.Flags().BoolVar(&configInitForce, "force", false, "Replace existing keys without asking")
+	configCmd.AddCommand(configInitCmd)
+	rootCmd.AddCommand(configCmd)
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"github.com/spf13/viper"
+	"go.yaml.in/yaml/v3"
+)
+
+// configFilePath returns the config file in use, or the default location
+// for a new one.
+func configFilePath() (string, error) {
+	if cfgFile != "" {
+		return cfgFile, nil
+	}
+	if f := viper.ConfigFileUsed(); f != "" {
+		return f, nil
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(home, ".config", "steamer", "config.yaml"), nil
+}
+
+// setConfigValue sets the value at the key path (such as "profiles",
+// "work", "apikey") in the YAML config file at path. The file and any
+// missing mappings are created; comments and the order of existing keys
+// are kept. Only the file is changed, so values that came from the
+// environment are never written out.
+func setConfigValue(path, value string, keys ...string) error {
+	doc, err := readConfigDocument(path)
+	if err != nil {
+		return err
+	}
+	node := doc.Content[0]
+	for i, key := range keys {
+		if node.Kind != yaml.MappingNode {
+			return fmt.Errorf("%s: %s is not a mapping", path, key)
+		}
+		last := i == len(keys)-1
+		var child *yaml.Node
+		for j := 0; j < len(node.Content); j += 2 {
+			if strings.EqualFold(node.Content[j].Value, key) {
+				child = node.Content[j+1]
+				if last {
+					node.Content[j+1] = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+				}
+				break
+			}
+		}
+		if child == nil {
+			child = &yaml.Node{Kind: yaml.MappingNode, Tag: "!!map"}
+			if last {
+				child = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: value}
+			}
+			node.Content = append(node.Content, &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: key}, child)
+		}
+		node = child
+	}
+	return writeConfigDocument(path, doc)
+}
+
+// deleteConfigValue removes the value at the key path from the YAML config
+// file at path. It is not an error if the file or key doesn't exist.
+func deleteConfigValue(path string, keys ...string) error {
+	if _, err := os.Stat(path); errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+	doc, err := readConfigDocument(path)
+	if err != nil {
+		return err
+	}
+	node := doc.Content[0]
+	for i, key := range keys {
+		if node.Kind != yaml.MappingNode {
+			return nil
+		}
+		j := -1
+		for k := 0; k < len(node.Content); k += 2 {
+			if strings.EqualFold(node.Content[k].Value, key) {
+				j = k
+				break
+			}
+		}
+		if j < 0 {
+			return nil
+		}
+		if i == len(keys)-1 {
+			node.Content = slices.Delete(node.Content, j, j+2)
+			return writeConfigDocument(path, doc)
+		}
+		node = node.Content[j+1]
+	}
+	return nil
+}
+
+// readConfigDocument parses the config file at path, returning an empty
+// mapping document if it doesn't exist yet.
+func readConfigDocument(path string) (*yaml.Node, error) {
+	doc := &yaml.Node{Kind: yaml.DocumentNode}
+	data, err := os.ReadFile(path)
+	if err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return nil, err
+	}
+	if err := yaml.Unmarshal(data, doc); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	if len(doc.Content) == 0 {
+		doc.Kind = yaml.DocumentNode
+		doc.Content = []*yaml.Node{{Kind: yaml.MappingNode, Tag: "!!map"}}
+	}
+	if doc.Content[0].Kind != yaml.MappingNode {
+		return nil, fmt.Errorf("%s: top level is not a mapping", path)
+	}
+	return doc, nil
+}
+
+// writeConfigDocument writes doc to path, readable only by the owner since
+// the file usually holds API keys.
+func writeConfigDocument(path string, doc *yaml.Node) error {
+	var buf bytes.Buffer
+	enc := yaml.NewEncoder(&buf)
+	enc.SetIndent(2)
+	if err := enc.Encode(doc); err != nil {
+		return err
+	}
+	if err := enc.Close(); err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	_, err := installFile(path, buf.Bytes(), 0o600)
+	return err
+}
@@ -0,0 +1,210 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/credentials"
+
+	"github.com/spf13/viper"
+	"golang.org/x/term"
+)
+
+// keyringService is the service name steamer's keyring entries use.
+const keyringService = "steamer"
+
+// resolvedCredentials are a profile's API keys and where each came from:
+// "config" (config file, .env or environment), "apikey_command",
+// "secret_command", "keyring" or "file".
+type resolvedCredentials struct {
+	APIKey       string
+	SecretKey    string
+	APIKeySource string
+	SecretSource string
+}
+
+// resolveCredentials looks up the keys of profile. Keys set directly in
+// the config file or environment win; missing ones are read from the
+// apikey_command and secret_command settings, and then from the store
+// recorded by 'steamer auth login'. With fetch false, commands and stores
+// are not consulted and only their sources are reported, so listing
+// profiles never runs commands or prompts for a passphrase.
+func resolveCredentials(ctx context.Context, profile string, fetch bool) (resolvedCredentials, error) {
+	var rc resolvedCredentials
+	if profile != defaultProfile && !viper.IsSet("profiles."+profile) {
+		return rc, fmt.Errorf("profile %q is not defined in the config file (available: %s)", profile, strings.Join(profileNames(), ", "))
+	}
+	get := func(key string) string {
+		return viper.GetString(profileSettingKey(profile, key))
+	}
+
+	rc.APIKey, rc.SecretKey = credentialsFrom(get)
+	if rc.APIKey != "" {
+		rc.APIKeySource = "config"
+	}
+	if rc.SecretKey != "" {
+		rc.SecretSource = "config"
+	}
+
+	for _, k := range []struct {
+		value, source *string
+		setting       string
+	}{
+		{&rc.APIKey, &rc.APIKeySource, "apikey_command"},
+		{&rc.SecretKey, &rc.SecretSource, "secret_command"},
+	} {
+		command := get(k.setting)
+		if *k.value != "" || command == "" {
+			continue
+		}
+		*k.source = k.setting
+		if !fetch {
+			continue
+		}
+		v, err := credentials.Command(ctx, command)
+		if err != nil {
+			return rc, fmt.Errorf("profile %q: %s: %w", profile, k.setting, err)
+		}
+		*k.value = v
+	}
+
+	if storeName := get("store"); storeName != "" && (rc.APIKeySource == "" || rc.SecretSource == "") {
+		store, err := credentialStore(storeName)
+		if err != nil {
+			return rc, fmt.Errorf("profile %q: %w", profile, err)
+		}
+		if rc.APIKeySource == "" {
+			rc.APIKeySource = store.Name()
+		}
+		if rc.SecretSource == "" {
+			rc.SecretSource = store.Name()
+		}
+		if fetch {
+			c, err := store.Get(profile)
+			if errors.Is(err, credentials.ErrNotFound) {
+				return rc, fmt.Errorf("profile %q: no credentials in the %s store; run 'steamer auth login --profile %s'", profile, store.Name(), profile)
+			}
+			if err != nil {
+				return rc, fmt.Errorf("profile %q: %w", profile, err)
+			}
+			if rc.APIKey == "" {
+				rc.APIKey = c.APIKey
+			}
+			if rc.SecretKey == "" {
+				rc.SecretKey = c.SecretAPIKey
+			}
+		}
+	}
+
+	if rc.APIKeySource == "" || rc.SecretSource == "" {
+		if profile != defaultProfile {
+			return rc, fmt.Errorf("profile %q needs both an API key and a secret (apikey/secretapikey, apikey_command/secret_command, or 'steamer auth login')", profile)
+		}
+		return rc, fmt.Errorf("porkbun API key and secret must be provided via config file (~/.config/steamer/config.yaml), .env, environment variables or 'steamer auth login' - hint: create a config file at ~/.config/steamer/config.yaml or set PORKBUN_APIKEY and PORKBUN_SECRETAPIKEY environment variables")
+	}
+	return rc, nil
+}
+
+// profileSettingKey returns the viper key of a per-profile setting. The
+// default profile uses the top level of the config file unless the
+// profiles map defines it.
+func profileSettingKey(profile, key string) string {
+	return strings.Join(append(profileConfigPath(profile), key), ".")
+}
+
+// profileConfigPath returns the key path of a profile's settings in the
+// config file, for use with setConfigValue.
+func profileConfigPath(profile string) []string {
+	if profile == defaultProfile && !viper.IsSet("profiles."+defaultProfile) {
+		return nil
+	}
+	return []string{"profiles", profile}
+}
+
+// credentialStore returns the store with the given name.
+func credentialStore(name string) (credentials.Store, error) {
+	switch name {
+	case credentials.KeyringStore:
+		return credentials.Keyring{Service: keyringService}, nil
+	case credentials.FileStore:
+		path, err := credentialsFilePath()
+		if err != nil {
+			return nil, err
+		}
+		return &credentials.File{Path: path, Passphrase: readPassphrase}, nil
+	}
+	return nil, fmt.Errorf("unknown credential store %q (use %s or %s)", name, credentials.KeyringStore, credentials.FileStore)
+}
+
+// credentialsFilePath returns the encrypted credentials file, which lives
+// next to the config file.
+func credentialsFilePath() (string, error) {
+	path, err := configFilePath()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(filepath.Dir(path), "credentials.enc"), nil
+}
+
+// readPassphrase returns STEAMER_PASSPHRASE, or asks for the passphrase of
+// the credentials file on the terminal, twice when the file is created.
+func readPassphrase(create bool) ([]byte, error) {
+	if p := os.Getenv("STEAMER_PASSPHRASE"); p != "" {
+		return []byte(p), nil
+	}
+	if !term.IsTerminal(int(os.Stdin.Fd())) {
+		return nil, errors.New("the credentials file is encrypted: set STEAMER_PASSPHRASE or run steamer in a terminal")
+	}
+	p, err := readSecret("Passphrase for the steamer credentials file: ")
+	if err != nil {
+		return nil, err
+	}
+	if create {
+		again, err := readSecret("Repeat passphrase: ")
+		if err != nil {
+			return nil, err
+		}
+		if again != p {
+			return nil, errors.New("passphrases do not match")
+		}
+	}
+	return []byte(p), nil
+}
+
+// readSecret prompts on stderr and reads a line without echoing it when
+// stdin is a terminal, or reads the next line of stdin otherwise.
+func readSecret(prompt string) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		line, err := stdinReader.ReadString('\n')
+		if err != nil && line == "" {
+			return "", err
+		}
+		return strings.TrimSpace(line), nil
+	}
+	fmt.Fprint(os.Stderr, prompt)
+	b, err := term.ReadPassword(fd)
+	fmt.Fprintln(os.Stderr)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(string(b)), nil
+}
@@ -0,0 +1,272 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+	"net/http"
+	"net/netip"
+	"os"
+	"os/signal"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	ddnsIPv4     bool
+	ddnsIPv6     bool
+	ddnsOnce     bool
+	ddnsInterval time.Duration
+)
+
+var ddnsCmd = &cobra.Command{
+	Use:     "ddns [domain] [subdomain...]",
+	Short:   "Keep A/AAAA records pointed at this machine's public IP",
+	GroupID: GroupManagement,
+	Long: `Runs a dynamic DNS updater. The public address is taken from Porkbun's ping endpoint, which reports the IP the request came from; IPv4 and IPv6 are looked up over separate connections so each family is tracked independently.
+
+Records are only edited (or created, if missing) when the address actually changes. Without --once, steamer keeps running and checks again every --interval until it receives SIGINT or SIGTERM, which makes it suitable for a systemd service. Use "" or @ for the root domain; with no subdomains, the root is updated.`,
+	Example: `  # Update home.aaie.cloud once and exit
+  steamer ddns aaie.cloud home --once
+
+  # Keep the root and vpn A and AAAA records current, checking every 10 minutes
+  steamer ddns aaie.cloud @ vpn --ipv6 --interval 10m`,
+	Args: cobra.MinimumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		if ddnsInterval <= 0 {
+			fmt.Println(theme.Fail.Render("--interval must be positive"))
+			os.Exit(ExitInvalid)
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+		if !ddnsIPv4 && !ddnsIPv6 {
+			fmt.Println("Nothing to do: both --ipv4 and --ipv6 are disabled")
+			os.Exit(1)
+		}
+
+		domain := args[0]
+		hosts := []string{""}
+		if len(args) > 1 {
+			hosts = hosts[:0]
+			for _, h := range args[1:] {
+				if h == "@" {
+					h = ""
+				}
+				hosts = append(hosts, porkbun.Subdomain(h, domain))
+			}
+		}
+
+		u := &ddnsUpdater{
+			client: client,
+			domain: domain,
+			hosts:  hosts,
+			last:   make(map[string]string),
+			logger: log.New(os.Stderr, "", log.LstdFlags),
+		}
+		if ddnsIPv4 {
+			u.families = append(u.families, ddnsFamily{recordType: "A", network: "tcp4", client: familyClient(client, "tcp4")})
+		}
+		if ddnsIPv6 {
+			u.families = append(u.families, ddnsFamily{recordType: "AAAA", network: "tcp6", client: familyClient(client, "tcp6")})
+		}
+
+		ctx, stop := signal.NotifyContext(cmd.Context(), syscall.SIGTERM)
+		defer stop()
+
+		ok := u.sync(ctx)
+		if ddnsOnce {
+			if !ok {
+				os.Exit(1)
+			}
+			return
+		}
+
+		u.logger.Printf("watching %s every %s", strings.Join(u.labels(), ", "), ddnsInterval)
+		ticker := time.NewTicker(ddnsInterval)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				u.logger.Printf("received shutdown signal, exiting")
+				return
+			case <-ticker.C:
+				u.sync(ctx)
+			}
+		}
+	},
+}
+
+// ddnsFamily is one address family the updater keeps in sync. Its client
+// only dials over the given network, so Ping reports the address for that
+// family.
+type ddnsFamily struct {
+	recordType string
+	network    string
+	client     *porkbun.Client
+}
+
+type ddnsUpdater struct {
+	client   *porkbun.Client
+	domain   string
+	hosts    []string
+	families []ddnsFamily
+	last     map[string]string // record type -> last address applied
+	logger   *log.Logger
+}
+
+// sync checks every family once and reports whether all of them are in
+// sync. A family whose records failed to update is retried on the next run
+// even if the address hasn't changed again.
+func (u *ddnsUpdater) sync(ctx context.Context) bool {
+	ok := true
+	for _, f := range u.families {
+		if !u.syncFamily(ctx, f) {
+			ok = false
+		}
+	}
+	return ok
+}
+
+func (u *ddnsUpdater) syncFamily(ctx context.Context, f ddnsFamily) bool {
+	ping, err := f.client.PingContext(ctx)
+	if err != nil {
+		u.logger.Printf("%s: could not determine public address over %s: %v", f.recordType, f.network, err)
+		return false
+	}
+	addr, err := netip.ParseAddr(ping.YourIP)
+	if err != nil || (f.recordType == "A") != addr.Is4() {
+		u.logger.Printf("%s: ping returned %q, which is not a usable address", f.recordType, ping.YourIP)
+		return false
+	}
+	ip := addr.String()
+
+	previous := u.last[f.recordType]
+	if ip == previous {
+		return true
+	}
+	if previous == "" {
+		u.logger.Printf("%s: public address is %s", f.recordType, ip)
+	} else {
+		u.logger.Printf("%s: public address changed %s -> %s", f.recordType, previous, ip)
+	}
+
+	records, err := u.client.RetrieveRecordsContext(ctx, u.domain)
+	if err != nil {
+		u.logger.Printf("%s: error retrieving records for %s: %v", f.recordType, u.domain, err)
+		return false
+	}
+
+	ok := true
+	for _, host := range u.hosts {
+		if err := u.syncHost(ctx, f.recordType, host, ip, records); err != nil {
+			u.logger.Printf("%s %s: %v", f.recordType, recordLabel(host, u.domain), err)
+			ok = false
+		}
+	}
+	if ok {
+		u.last[f.recordType] = ip
+	}
+	return ok
+}
+
+// syncHost points a single hostname at ip, creating the record if there is
+// none and leaving it alone if it already matches.
+func (u *ddnsUpdater) syncHost(ctx context.Context, recordType, host, ip string, records []porkbun.DNSRecord) error {
+	label := recordLabel(host, u.domain)
+	var matches []porkbun.DNSRecord
+	for _, r := range records {
+		if r.Type == recordType && porkbun.Subdomain(r.Name, u.domain) == host {
+			matches = append(matches, r)
+		}
+	}
+
+	switch len(matches) {
+	case 0:
+		id, err := u.client.CreateRecordContext(ctx, u.domain, porkbun.CreateRecordRequest{
+			Name:    host,
+			Type:    recordType,
+			Content: ip,
+		})
+		if err != nil {
+			return fmt.Errorf("creating record: %w", err)
+		}
+		u.logger.Printf("%s %s: created -> %s (ID: %s)", recordType, label, ip, id)
+	case 1:
+		r := matches[0]
+		if r.Content == ip {
+			u.logger.Printf("%s %s: already %s", recordType, label, ip)
+			return nil
+		}
+		err := u.client.EditRecordContext(ctx, u.domain, r.ID, porkbun.EditRecordRequest{
+			Name:    host,
+			Type:    recordType,
+			Content: ip,
+			TTL:     strconv.Itoa(r.TTL),
+			Prio:    strconv.Itoa(r.Prio),
+		})
+		if err != nil {
+			return fmt.Errorf("updating record: %w", err)
+		}
+		u.logger.Printf("%s %s: %s -> %s", recordType, label, r.Content, ip)
+	default:
+		return fmt.Errorf("found %d %s records, refusing to guess which one to update", len(matches), recordType)
+	}
+	return nil
+}
+
+func (u *ddnsUpdater) labels() []string {
+	var labels []string
+	for _, f := range u.families {
+		for _, h := range u.hosts {
+			labels = append(labels, f.recordType+" "+recordLabel(h, u.domain))
+		}
+	}
+	return labels
+}
+
+// familyClient returns a copy of client whose connections are restricted to
+// network ("tcp4" or "tcp6").
+func familyClient(client *porkbun.Client, network string) *porkbun.Client {
+	dialer := &net.Dialer{Timeout: 30 * time.Second}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.DialContext = func(ctx context.Context, _, addr string) (net.Conn, error) {
+		return dialer.DialContext(ctx, network, addr)
+	}
+	family := *client
+	family.HTTPClient = &http.Client{Transport: transport, Timeout: client.HTTPClient.Timeout}
+	return &family
+}
+
+func init() {
+	ddnsCmd.Flags().BoolVar(&ddnsIPv4, "ipv4", true, "Keep A records updated with the public IPv4 address")
+	ddnsCmd.Flags().BoolVar(&ddnsIPv6, "ipv6", false, "Keep AAAA records updated with the public IPv6 address")
+	ddnsCmd.Flags().BoolVar(&ddnsOnce, "once", false, "Update once and exit instead of running continuously")
+	ddnsCmd.Flags().DurationVar(&ddnsInterval, "interval", 5*time.Minute, "How often to check the public address")
+	rootCmd.AddCommand(ddnsCmd)
+}
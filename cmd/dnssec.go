@@ -0,0 +1,251 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/dnssec"
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	dnssecJSON       bool
+	dnssecKeyTag     uint16
+	dnssecAlg        uint8
+	dnssecDigestType uint8
+	dnssecDigest     string
+	dnssecDNSKEY     string
+	dnssecKeyFile    string
+)
+
+var dnssecCmd = &cobra.Command{
+	Use:     "dnssec",
+	Short:   "Manage DNSSEC DS records at the registry",
+	GroupID: GroupManagement,
+	Long: `Lists, registers and removes the DS records that link a signed zone to its parent.
+
+A DS record can be given as raw values (--key-tag, --alg, --digest-type, --digest) or derived from the zone's key signing key with --dnskey or --key-file, in which case steamer computes the key tag and the SHA-256 (--digest-type 2, the default) or SHA-384 (--digest-type 4) digest itself, as dnssec-dsfromkey does.`,
+	Example: `  # Show registered DS records
+  steamer dnssec list aaie.cloud
+
+  # Compute the DS record for a BIND key file without changing anything
+  steamer dnssec ds aaie.cloud --key-file Kaaie.cloud.+013+02371.key
+
+  # Register the DS record for a DNSKEY
+  steamer dnssec create aaie.cloud --dnskey "257 3 13 mdsswUyr3DPW132mOi8V9xESWE8jTo0d..."
+
+  # Register raw DS values
+  steamer dnssec create aaie.cloud --key-tag 2371 --alg 13 --digest-type 2 --digest 1F98...
+
+  # Remove the DS record with key tag 2371
+  steamer dnssec delete aaie.cloud 2371`,
+}
+
+var dnssecListCmd = &cobra.Command{
+	Use:         "list [domain]",
+	Annotations: readOnly,
+	Short:       "List DS records for a domain",
+	Args:        cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		domain := args[0]
+		records, err := client.GetDnssecRecordsContext(cmd.Context(), domain)
+		if err != nil {
+			fmt.Printf("Error retrieving DNSSEC records for %s: %v\n", domain, err)
+			os.Exit(exitCode(err))
+		}
+
+		if dnssecJSON {
+			b, err := json.MarshalIndent(records, "", "  ")
+			if err != nil {
+				fmt.Printf("Error encoding JSON: %v\n", err)
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(string(b))
+			return
+		}
+
+		if len(records) == 0 {
+			fmt.Println(theme.Muted.Render(fmt.Sprintf("No DS records for %s", domain)))
+			return
+		}
+		fmt.Printf("%s %s %s %s\n",
+			theme.Accent.Render(fmt.Sprintf("%-8s", "KEYTAG")),
+			theme.Accent.Render(fmt.Sprintf("%-5s", "ALG")),
+			theme.Accent.Render(fmt.Sprintf("%-5s", "TYPE")),
+			theme.Accent.Render("DIGEST"),
+		)
+		for _, r := range records {
+			fmt.Printf("%-8s %-5s %-5s %s\n", r.KeyTag, r.Alg, r.DigestType, r.Digest)
+		}
+	},
+}
+
+var dnssecDSCmd = &cobra.Command{
+	Use:   "ds [domain]",
+	Short: "Compute the DS record for a DNSKEY without registering it",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		domain := args[0]
+		ds := dsFromKey(domain)
+		fmt.Printf("%s. IN DS %s\n", strings.TrimSuffix(domain, "."), ds)
+	},
+}
+
+var dnssecCreateCmd = &cobra.Command{
+	Use:   "create [domain]",
+	Short: "Register a DS record for a domain",
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		domain := args[0]
+
+		var record porkbun.DSRecord
+		if cmd.Flags().Changed("dnskey") || cmd.Flags().Changed("key-file") {
+			for _, name := range []string{"key-tag", "alg", "digest"} {
+				if cmd.Flags().Changed(name) {
+					fmt.Printf("--%s can't be combined with --dnskey or --key-file\n", name)
+					os.Exit(ExitInvalid)
+				}
+			}
+			ds := dsFromKey(domain)
+			fmt.Println(theme.Muted.Render(fmt.Sprintf("Computed DS %s", ds)))
+			record = porkbun.DSRecord{
+				KeyTag:     strconv.Itoa(int(ds.KeyTag)),
+				Alg:        strconv.Itoa(int(ds.Algorithm)),
+				DigestType: strconv.Itoa(int(ds.DigestType)),
+				Digest:     ds.HexDigest(),
+			}
+		} else {
+			for _, name := range []string{"key-tag", "alg", "digest"} {
+				if !cmd.Flags().Changed(name) {
+					fmt.Printf("--%s is required unless --dnskey or --key-file is given\n", name)
+					os.Exit(ExitInvalid)
+				}
+			}
+			record = porkbun.DSRecord{
+				KeyTag:     strconv.Itoa(int(dnssecKeyTag)),
+				Alg:        strconv.Itoa(int(dnssecAlg)),
+				DigestType: strconv.Itoa(int(dnssecDigestType)),
+				Digest:     strings.ToUpper(strings.ReplaceAll(dnssecDigest, " ", "")),
+			}
+		}
+		if err := porkbun.ValidateDSRecord(record); err != nil {
+			fmt.Println(theme.Fail.Render(err.Error()))
+			os.Exit(exitCode(err))
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+		if err := client.CreateDnssecRecordContext(cmd.Context(), domain, record); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error creating DS record: %v", err)))
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Registered DS record %s for %s", record.KeyTag, domain)))
+	},
+}
+
+var dnssecDeleteCmd = &cobra.Command{
+	Use:   "delete [domain] [key-tag]",
+	Short: "Remove a DS record by key tag",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		domain, keyTag := args[0], args[1]
+		if err := client.DeleteDnssecRecordContext(cmd.Context(), domain, keyTag); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error deleting DS record: %v", err)))
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Removed DS record %s from %s", keyTag, domain)))
+	},
+}
+
+// dsFromKey reads the DNSKEY given by --dnskey or --key-file and computes
+// its DS record for domain.
+func dsFromKey(domain string) *dnssec.DS {
+	var (
+		key *dnssec.DNSKEY
+		err error
+	)
+	switch {
+	case dnssecDNSKEY != "" && dnssecKeyFile != "":
+		err = fmt.Errorf("use either --dnskey or --key-file, not both")
+	case dnssecDNSKEY != "":
+		key, err = dnssec.ParseDNSKEY(dnssecDNSKEY)
+	case dnssecKeyFile != "":
+		key, err = dnssec.ReadKeyFile(dnssecKeyFile)
+	default:
+		err = fmt.Errorf("a DNSKEY is required: pass --dnskey or --key-file")
+	}
+	if err != nil {
+		fmt.Println(theme.Fail.Render(err.Error()))
+		os.Exit(ExitInvalid)
+	}
+
+	zone := strings.ToLower(strings.TrimSuffix(domain, "."))
+	if key.Owner != "" && key.Owner != zone {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("The DNSKEY belongs to %s, not %s", key.Owner, zone)))
+		os.Exit(ExitInvalid)
+	}
+	if !key.IsKSK() {
+		fmt.Fprintln(os.Stderr, theme.Warn.Render(fmt.Sprintf("Warning: key %d has flags %d and is not a key signing key (257); DS records normally point at the KSK", key.KeyTag(), key.Flags)))
+	}
+
+	ds, err := key.DS(zone, dnssecDigestType)
+	if err != nil {
+		fmt.Println(theme.Fail.Render(err.Error()))
+		os.Exit(ExitInvalid)
+	}
+	return ds
+}
+
+func init() {
+	dnssecListCmd.Flags().BoolVar(&dnssecJSON, "json", false, "Output results in JSON format")
+
+	for _, c := range []*cobra.Command{dnssecCreateCmd, dnssecDSCmd} {
+		c.Flags().StringVar(&dnssecDNSKEY, "dnskey", "", "DNSKEY record to derive the DS record from")
+		c.Flags().StringVar(&dnssecKeyFile, "key-file", "", "BIND K*.key file to derive the DS record from")
+		c.Flags().Uint8Var(&dnssecDigestType, "digest-type", dnssec.DigestSHA256, "Digest type: 2 (SHA-256) or 4 (SHA-384)")
+	}
+	dnssecCreateCmd.Flags().Uint16Var(&dnssecKeyTag, "key-tag", 0, "Key tag of the DNSKEY")
+	dnssecCreateCmd.Flags().Uint8Var(&dnssecAlg, "alg", 0, "DNSSEC algorithm number (e.g. 13 for ECDSA P-256)")
+	dnssecCreateCmd.Flags().StringVar(&dnssecDigest, "digest", "", "Hex-encoded DS digest")
+
+	dnssecCmd.AddCommand(dnssecListCmd)
+	dnssecCmd.AddCommand(dnssecDSCmd)
+	dnssecCmd.AddCommand(dnssecCreateCmd)
+	dnssecCmd.AddCommand(dnssecDeleteCmd)
+	rootCmd.AddCommand(dnssecCmd)
+}
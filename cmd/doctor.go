@@ -0,0 +1,361 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"net/http"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/joho/godotenv"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// Clock skew thresholds for doctor. The HTTP Date header has a resolution
+// of one second, so anything below clockSkewWarn is noise.
+const (
+	clockSkewWarn = 30 * time.Second
+	clockSkewFail = 5 * time.Minute
+)
+
+var doctorCmd = &cobra.Command{
+	Use:     "doctor",
+	Short:   "Diagnose configuration, credentials and connectivity",
+	GroupID: GroupConfig,
+	Long: `Shows where steamer looks for its configuration and what it found: config files in search order and which one is used, .env, the relevant environment variables, the active profile and where each API key came from (masked). It then checks file permissions, the local clock against Porkbun's, and whether the API is reachable and accepts the keys.
+
+The exit status is non-zero if any check failed.`,
+	Example: `  # Check the active profile
+  steamer doctor
+
+  # Check another profile
+  steamer doctor --profile acme-corp`,
+	Args: cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		d := &doctor{}
+		d.checkConfigFiles()
+		d.checkDotEnv()
+		d.checkEnvironment()
+		rc, ok := d.checkCredentials(cmd.Context())
+		d.checkAPI(cmd.Context(), rc, ok)
+
+		fmt.Println()
+		if d.failures > 0 {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("%d problem(s) found", d.failures)))
+			os.Exit(1)
+		}
+		fmt.Println(theme.Pass.Render("No problems found"))
+	},
+}
+
+// doctor prints the checks and counts the failures.
+type doctor struct {
+	failures int
+	// dotenv holds the variables defined in ./.env.
+	dotenv map[string]string
+}
+
+func (d *doctor) section(title string) {
+	fmt.Println()
+	fmt.Println(theme.Accent.Render(title))
+}
+
+func (d *doctor) ok(format string, args ...any) {
+	fmt.Println("  " + theme.Pass.Render("✓") + " " + fmt.Sprintf(format, args...))
+}
+
+func (d *doctor) info(format string, args ...any) {
+	fmt.Println("  " + theme.Muted.Render("·") + " " + fmt.Sprintf(format, args...))
+}
+
+func (d *doctor) warn(format string, args ...any) {
+	fmt.Println("  " + theme.Warn.Render("!") + " " + fmt.Sprintf(format, args...))
+}
+
+func (d *doctor) fail(format string, args ...any) {
+	d.failures++
+	fmt.Println("  " + theme.Fail.Render("✗") + " " + fmt.Sprintf(format, args...))
+}
+
+func (d *doctor) checkConfigFiles() {
+	d.section("Config file")
+	used := viper.ConfigFileUsed()
+
+	var found []string
+	if cfgFile != "" {
+		d.info("--config %s", cfgFile)
+		if _, err := os.Stat(cfgFile); err != nil {
+			d.fail("%v", err)
+			return
+		}
+		found = []string{cfgFile}
+	} else {
+		dirs := configSearchDirs()
+		d.info("searched %s for %s", strings.Join(dirs, ", "), strings.Join(configNames, ".*, ")+".*")
+		for _, name := range configNames {
+			for _, dir := range dirs {
+				for _, ext := range viper.SupportedExts {
+					path := filepath.Join(dir, name+"."+ext)
+					if _, err := os.Stat(path); err == nil && !slices.Contains(found, path) {
+						found = append(found, path)
+					}
+				}
+			}
+		}
+	}
+
+	if len(found) == 0 {
+		d.warn("no config file found; run 'steamer config init' to create one")
+	}
+	for _, path := range found {
+		if path != used {
+			d.warn("%s exists but is ignored", path)
+			continue
+		}
+		v := viper.New()
+		v.SetConfigFile(path)
+		if filepath.Ext(path) == "" {
+			v.SetConfigType("yaml")
+		}
+		if err := v.ReadInConfig(); err != nil {
+			d.fail("%s can't be read: %v", path, err)
+		} else {
+			d.ok("using %s", path)
+		}
+		d.checkPermissions(path)
+	}
+
+	if path, err := credentialsFilePath(); err == nil {
+		if _, err := os.Stat(path); err == nil {
+			d.info("encrypted credentials file %s", path)
+			d.checkPermissions(path)
+		}
+	}
+}
+
+// checkPermissions warns about files holding secrets that others can read.
+func (d *doctor) checkPermissions(path string) {
+	info, err := os.Stat(path)
+	if err != nil {
+		return
+	}
+	if mode := info.Mode().Perm(); mode&0o077 != 0 {
+		d.fail("%s is accessible to other users (mode %04o); run: chmod 600 %s", path, mode, path)
+	}
+}
+
+func (d *doctor) checkDotEnv() {
+	d.section(".env")
+	wd, _ := os.Getwd()
+	env, err := godotenv.Read(".env")
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		d.info("no .env in %s", wd)
+	case err != nil:
+		d.fail("%s can't be read: %v", filepath.Join(wd, ".env"), err)
+	default:
+		d.dotenv = env
+		var names []string
+		for name := range env {
+			names = append(names, name)
+		}
+		slices.Sort(names)
+		d.ok("%s defines %s", filepath.Join(wd, ".env"), strings.Join(names, ", "))
+	}
+}
+
+func (d *doctor) checkEnvironment() {
+	d.section("Environment")
+	for _, name := range []string{"PORKBUN_APIKEY", "PORKBUN_SECRETAPIKEY", "API_KEY", "API_SECRET", "PORKBUN_API_ENDPOINT", "STEAMER_PROFILE", "STEAMER_PASSPHRASE"} {
+		v, set := os.LookupEnv(name)
+		if !set {
+			d.info("%-22s %s", name, theme.Muted.Render("not set"))
+			continue
+		}
+		shown := v
+		switch name {
+		case "PORKBUN_API_ENDPOINT", "STEAMER_PROFILE":
+		case "STEAMER_PASSPHRASE":
+			shown = "(set)"
+		default:
+			shown = maskSecret(v)
+		}
+		if d.dotenv[name] == v {
+			shown += theme.Muted.Render(" (from .env)")
+		}
+		d.info("%-22s %s", name, shown)
+	}
+}
+
+// checkCredentials reports the active profile and where its keys come
+// from, and whether they could be resolved.
+func (d *doctor) checkCredentials(ctx context.Context) (resolvedCredentials, bool) {
+	d.section("Credentials")
+	profile := activeProfile()
+	var reason string
+	switch {
+	case rootCmd.PersistentFlags().Changed("profile"):
+		reason = "--profile"
+	case os.Getenv("STEAMER_PROFILE") != "":
+		reason = "STEAMER_PROFILE"
+	case viper.InConfig("profile"):
+		reason = "profile key in " + viper.ConfigFileUsed()
+	default:
+		reason = "no profile selected"
+	}
+	configured := strings.Join(profileNames(), ", ")
+	if configured == "" {
+		configured = "none"
+	}
+	d.info("profile %s (%s); configured: %s", theme.Accent.Render(profile), reason, configured)
+
+	rc, err := resolveCredentials(ctx, profile, true)
+	if err != nil {
+		d.fail("%v", err)
+		return rc, false
+	}
+	d.ok("API key     %s from %s", maskSecret(rc.APIKey), d.keyOrigin(profile, rc.APIKeySource, "apikey", "api_key"))
+	d.ok("secret key  %s from %s", maskSecret(rc.SecretKey), d.keyOrigin(profile, rc.SecretSource, "secretapikey", "api_secret", "apisecret", "secretkey"))
+	if strings.HasPrefix(rc.APIKey, "sk1_") || strings.HasPrefix(rc.SecretKey, "pk1_") {
+		d.fail("the API key and secret look swapped: API keys start with pk1_ and secrets with sk1_")
+	} else if !strings.HasPrefix(rc.APIKey, "pk1_") || !strings.HasPrefix(rc.SecretKey, "sk1_") {
+		d.warn("Porkbun API keys usually start with pk1_ and secrets with sk1_")
+	}
+	return rc, true
+}
+
+// keyOrigin describes where a key resolved from source came from. For keys
+// from the config file or environment, keys are the setting names tried.
+func (d *doctor) keyOrigin(profile, source string, keys ...string) string {
+	switch source {
+	case "apikey_command", "secret_command":
+		return fmt.Sprintf("%s (%s)", source, viper.GetString(profileSettingKey(profile, source)))
+	case "keyring":
+		return "the OS keyring"
+	case "file":
+		path, _ := credentialsFilePath()
+		return "encrypted file " + path
+	}
+
+	var key string
+	for _, k := range keys {
+		if viper.GetString(profileSettingKey(profile, k)) != "" {
+			key = k
+			break
+		}
+	}
+	if len(profileConfigPath(profile)) > 0 {
+		return fmt.Sprintf("%s in %s", profileSettingKey(profile, key), viper.ConfigFileUsed())
+	}
+
+	// Mirror initConfig: PORKBUN_* variables override the config file, and
+	// API_KEY/API_SECRET only fill in what the config file lacks.
+	value := viper.GetString(key)
+	envName := "PORKBUN_" + strings.ToUpper(key)
+	if os.Getenv(envName) == "" {
+		if viper.InConfig(key) {
+			return fmt.Sprintf("%s in %s", key, viper.ConfigFileUsed())
+		}
+		switch key {
+		case "apikey":
+			envName = "API_KEY"
+		case "secretapikey":
+			envName = "API_SECRET"
+		}
+	}
+	if os.Getenv(envName) == value {
+		if d.dotenv[envName] == value {
+			return envName + " in .env"
+		}
+		return envName + " environment variable"
+	}
+	return "the environment"
+}
+
+// checkAPI compares the local clock with Porkbun's and checks that the API
+// is reachable and accepts the keys.
+func (d *doctor) checkAPI(ctx context.Context, rc resolvedCredentials, haveKeys bool) {
+	d.section("Porkbun API")
+	endpoint := viper.GetString("api_endpoint")
+	if endpoint == "" {
+		endpoint = porkbun.DefaultBaseURL
+	}
+	d.info("endpoint %s", endpoint)
+	client := clientWithKeys(rc.APIKey, rc.SecretKey)
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodHead, endpoint, nil)
+	if err != nil {
+		d.fail("invalid endpoint: %v", err)
+		return
+	}
+	start := time.Now()
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		d.fail("can't reach %s: %v", endpoint, err)
+		return
+	}
+	resp.Body.Close()
+	rtt := time.Since(start)
+	d.ok("reachable (%s)", rtt.Round(time.Millisecond))
+
+	if serverTime, err := http.ParseTime(resp.Header.Get("Date")); err != nil {
+		d.warn("can't check the clock: no usable Date header")
+	} else {
+		skew := start.Add(rtt / 2).Sub(serverTime).Round(time.Second)
+		direction := "ahead of"
+		if skew < 0 {
+			skew, direction = -skew, "behind"
+		}
+		switch {
+		case skew < clockSkewWarn:
+			d.ok("local clock matches Porkbun's")
+		case skew < clockSkewFail:
+			d.warn("local clock is %s %s Porkbun's", skew, direction)
+		default:
+			d.fail("local clock is %s %s Porkbun's; TLS, DNSSEC and ACME checks may fail until it is synchronized", skew, direction)
+		}
+	}
+
+	if !haveKeys {
+		d.info("skipping the credential check: no keys")
+		return
+	}
+	start = time.Now()
+	ping, err := client.PingContext(ctx)
+	if err != nil {
+		if errors.Is(err, porkbun.ErrUnauthorized) {
+			d.fail("Porkbun rejected the keys: %v (check them, and that API access is enabled at https://porkbun.com/account/api)", err)
+			return
+		}
+		d.fail("ping failed: %v", err)
+		return
+	}
+	d.ok("keys accepted (your IP: %s, %s)", ping.YourIP, time.Since(start).Round(time.Millisecond))
+}
+
+func init() {
+	rootCmd.AddCommand(doctorCmd)
+}
@@ -0,0 +1,207 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	editContent  string
+	editTTL      int
+	editPrio     int
+	editNotes    string
+	editSelector recordSelector
+	editAll      bool
+)
+
+var editCmd = &cobra.Command{
+	Use:     "edit [domain] [record-id]",
+	Short:   "Edit an existing DNS record in place",
+	GroupID: GroupManagement,
+	Long: `Updates the content, TTL, priority or notes of an existing DNS record without changing its ID. Only the fields passed as flags are changed; everything else is kept as it is.
+
+Records are selected by ID, which can be found using the 'list-records' command, or with --name and/or --type. When a selection matches more than one record, nothing is changed unless --all is given.`,
+	Example: `  # Point record 123456789 at a new address
+  steamer edit aaie.cloud 123456789 --content 192.168.1.2
+
+  # Lower the TTL and clear the notes
+  steamer edit aaie.cloud 123456789 --ttl 600 --notes ""
+
+  # Point the A record for www.aaie.cloud at a new address
+  steamer edit aaie.cloud --name www --type A --content 192.168.1.2`,
+	Args: selectorArgs(&editSelector),
+	Run: func(cmd *cobra.Command, args []string) {
+		flags := cmd.Flags()
+		if !flags.Changed("content") && !flags.Changed("ttl") && !flags.Changed("prio") && !flags.Changed("notes") {
+			fmt.Println(theme.Fail.Render("Nothing to change: pass at least one of --content, --ttl, --prio or --notes"))
+			os.Exit(1)
+		}
+		if flags.Changed("ttl") {
+			if err := porkbun.ValidateTTL(editTTL); err != nil {
+				fmt.Println(theme.Fail.Render(err.Error()))
+				os.Exit(exitCode(err))
+			}
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		ctx := cmd.Context()
+		domain := args[0]
+
+		if !editSelector.set() {
+			id := args[1]
+			current, err := client.RetrieveRecordContext(ctx, domain, id)
+			if err != nil {
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("Error retrieving record %s: %v", id, err)))
+				os.Exit(exitCode(err))
+			}
+			validateEditContent(cmd, []porkbun.DNSRecord{*current})
+			editRecord(cmd, client, domain, current)
+			return
+		}
+
+		matches, err := editSelector.find(ctx, client, domain)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error retrieving records for %s: %v", domain, err)))
+			os.Exit(exitCode(err))
+		}
+		if len(matches) == 0 {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("No %s", editSelector.describe(domain))))
+			os.Exit(ExitNotFound)
+		}
+		if len(matches) > 1 && !editAll {
+			printRecordTable(matches)
+			fmt.Println()
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("%d %s match; refusing to edit more than one record without --all", len(matches), editSelector.describe(domain))))
+			os.Exit(ExitInvalid)
+		}
+		validateEditContent(cmd, matches)
+
+		// Every match gets the same content, so one request does it, as long
+		// as the TTL and priority it sends are right for all of them.
+		if len(matches) > 1 && editSelector.exact() && flags.Changed("content") && bulkEditable(cmd, matches) {
+			req := porkbun.EditByNameTypeRequest{
+				Content: editContent,
+				TTL:     strconv.Itoa(matches[0].TTL),
+				Prio:    strconv.Itoa(matches[0].Prio),
+			}
+			if flags.Changed("ttl") {
+				req.TTL = strconv.Itoa(editTTL)
+			}
+			if flags.Changed("prio") {
+				req.Prio = strconv.Itoa(editPrio)
+			}
+			if flags.Changed("notes") {
+				req.Notes = &editNotes
+			}
+			err := client.EditRecordsByNameTypeContext(ctx, domain, editSelector.typ, editSelector.subdomain(domain), req)
+			if err != nil {
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("Error editing records: %v", err)))
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully updated %d %s", len(matches), editSelector.describe(domain))))
+			return
+		}
+
+		for i := range matches {
+			editRecord(cmd, client, domain, &matches[i])
+		}
+	},
+}
+
+// validateEditContent exits if --content is not valid for every record in
+// records, before any of them is changed.
+func validateEditContent(cmd *cobra.Command, records []porkbun.DNSRecord) {
+	if !cmd.Flags().Changed("content") {
+		return
+	}
+	for _, r := range records {
+		if err := porkbun.ValidateContent(r.Type, editContent); err != nil {
+			fmt.Println(theme.Fail.Render(err.Error()))
+			os.Exit(exitCode(err))
+		}
+	}
+}
+
+// bulkEditable reports whether a single editByNameType request can update
+// matches. Porkbun resets the TTL and priority of every record it edits, so
+// unless the flags set them, the records must already agree on both.
+func bulkEditable(cmd *cobra.Command, matches []porkbun.DNSRecord) bool {
+	flags := cmd.Flags()
+	for _, r := range matches[1:] {
+		if !flags.Changed("ttl") && r.TTL != matches[0].TTL {
+			return false
+		}
+		if !flags.Changed("prio") && r.Prio != matches[0].Prio {
+			return false
+		}
+	}
+	return true
+}
+
+// editRecord applies the changed flags to current, keeping every other
+// field as it is.
+func editRecord(cmd *cobra.Command, client *porkbun.Client, domain string, current *porkbun.DNSRecord) {
+	flags := cmd.Flags()
+	id := current.ID
+	req := porkbun.EditRecordRequest{
+		Name:    porkbun.Subdomain(current.Name, domain),
+		Type:    current.Type,
+		Content: current.Content,
+		TTL:     strconv.Itoa(current.TTL),
+		Prio:    strconv.Itoa(current.Prio),
+	}
+	if flags.Changed("content") {
+		req.Content = editContent
+	}
+	if flags.Changed("ttl") {
+		req.TTL = strconv.Itoa(editTTL)
+	}
+	if flags.Changed("prio") {
+		req.Prio = strconv.Itoa(editPrio)
+	}
+	if flags.Changed("notes") {
+		req.Notes = &editNotes
+	}
+
+	if err := client.EditRecordContext(cmd.Context(), domain, id, req); err != nil {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("Error editing record: %v", err)))
+		os.Exit(exitCode(err))
+	}
+
+	fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully updated %s record %s on %s", current.Type, id, domain)))
+}
+
+func init() {
+	editCmd.Flags().StringVar(&editContent, "content", "", "New record content (IP address, target hostname, text)")
+	editCmd.Flags().IntVar(&editTTL, "ttl", porkbun.MinTTL, "New time to live in seconds (minimum 600)")
+	editCmd.Flags().IntVar(&editPrio, "prio", 0, "New priority (MX and SRV records)")
+	editCmd.Flags().StringVar(&editNotes, "notes", "", "New notes; pass an empty string to clear them")
+	editSelector.addFlags(editCmd)
+	editCmd.Flags().BoolVar(&editAll, "all", false, "Edit every record matching --name/--type")
+	rootCmd.AddCommand(editCmd)
+}
@@ -0,0 +1,63 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"errors"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+// Process exit codes, so scripts can tell kinds of failure apart.
+const (
+	// ExitError is used for failures that don't have a more specific code.
+	ExitError = 1
+	// ExitUnauthorized means the API credentials were rejected.
+	ExitUnauthorized = 3
+	// ExitNotFound means the domain or record doesn't exist.
+	ExitNotFound = 4
+	// ExitRateLimited means Porkbun's rate limit was still exceeded after
+	// retrying.
+	ExitRateLimited = 5
+	// ExitInvalid means the request failed validation, locally or at Porkbun.
+	ExitInvalid = 6
+	// ExitTimeout means a request timed out.
+	ExitTimeout = 7
+)
+
+// exitCode maps an error to the process exit code that describes it.
+func exitCode(err error) int {
+	switch {
+	case errors.Is(err, porkbun.ErrUnauthorized):
+		return ExitUnauthorized
+	case errors.Is(err, porkbun.ErrNotFound):
+		return ExitNotFound
+	case errors.Is(err, porkbun.ErrRateLimited):
+		return ExitRateLimited
+	case errors.Is(err, porkbun.ErrInvalidRequest):
+		return ExitInvalid
+	case errors.Is(err, context.DeadlineExceeded), isTimeout(err):
+		return ExitTimeout
+	}
+	return ExitError
+}
+
+// isTimeout catches http.Client timeouts, which don't wrap
+// context.DeadlineExceeded.
+func isTimeout(err error) bool {
+	var t interface{ Timeout() bool }
+	return errors.As(err, &t) && t.Timeout()
+}
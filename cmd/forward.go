@@ -0,0 +1,275 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	forwardJSON        bool
+	forwardAllDomains  bool
+	forwardSubdomain   string
+	forwardType        string
+	forwardIncludePath bool
+	forwardWildcard    bool
+)
+
+var forwardCmd = &cobra.Command{
+	Use:     "forward",
+	Short:   "Manage URL forwarding for domains",
+	GroupID: GroupManagement,
+	Long:    `Lists, adds and removes the URL forwards Porkbun serves for a domain, which redirect web requests for the domain or a subdomain to another URL.`,
+	Example: `  # Show forwards for aaie.cloud
+  steamer forward list aaie.cloud
+
+  # Show where every domain in the account forwards
+  steamer forward list --all-domains
+
+  # Permanently redirect aaie.cloud, keeping the path
+  steamer forward add aaie.cloud https://example.com --type 301 --include-path
+
+  # Remove forward 22049209
+  steamer forward rm aaie.cloud 22049209`,
+}
+
+// domainForwards is one domain's entry in the --all-domains report.
+type domainForwards struct {
+	Domain   string               `json:"domain"`
+	Forwards []porkbun.URLForward `json:"forwards"`
+	Error    string               `json:"error,omitempty"`
+}
+
+var forwardListCmd = &cobra.Command{
+	Use:         "list [domain]",
+	Annotations: readOnly,
+	Short:       "List URL forwards for a domain, or for all domains",
+	Args: func(cmd *cobra.Command, args []string) error {
+		if forwardAllDomains {
+			return cobra.NoArgs(cmd, args)
+		}
+		return cobra.ExactArgs(1)(cmd, args)
+	},
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+		ctx := cmd.Context()
+
+		report := []domainForwards{}
+		if forwardAllDomains {
+			failed := false
+			for d, err := range client.DomainsContext(ctx) {
+				if err != nil {
+					fmt.Printf("Error listing domains: %v\n", err)
+					os.Exit(exitCode(err))
+				}
+				entry := domainForwards{Domain: d.Domain, Forwards: []porkbun.URLForward{}}
+				forwards, err := client.GetURLForwardsContext(ctx, d.Domain)
+				if err != nil {
+					entry.Error = err.Error()
+					failed = true
+				} else if forwards != nil {
+					entry.Forwards = forwards
+				}
+				report = append(report, entry)
+			}
+			printForwards(report, true)
+			if failed {
+				os.Exit(1)
+			}
+			return
+		}
+
+		domain := args[0]
+		forwards, err := client.GetURLForwardsContext(ctx, domain)
+		if err != nil {
+			fmt.Printf("Error retrieving URL forwards for %s: %v\n", domain, err)
+			os.Exit(exitCode(err))
+		}
+		if forwards == nil {
+			forwards = []porkbun.URLForward{}
+		}
+		printForwards([]domainForwards{{Domain: domain, Forwards: forwards}}, false)
+	},
+}
+
+// printForwards prints the report as JSON or a table. With allDomains,
+// every domain gets a row even if it has no forwards.
+func printForwards(report []domainForwards, allDomains bool) {
+	if forwardJSON {
+		var v any = report
+		if !allDomains {
+			v = report[0].Forwards
+		}
+		b, err := json.MarshalIndent(v, "", "  ")
+		if err != nil {
+			fmt.Printf("Error encoding JSON: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(string(b))
+		return
+	}
+
+	if !allDomains && len(report[0].Forwards) == 0 {
+		fmt.Println(theme.Muted.Render(fmt.Sprintf("No URL forwards for %s", report[0].Domain)))
+		return
+	}
+	fmt.Printf("%s %s %s %s %s\n",
+		theme.Accent.Render(fmt.Sprintf("%-10s", "ID")),
+		theme.Accent.Render(fmt.Sprintf("%-30s", "FROM")),
+		theme.Accent.Render(fmt.Sprintf("%-5s", "TYPE")),
+		theme.Accent.Render(fmt.Sprintf("%-14s", "OPTIONS")),
+		theme.Accent.Render("LOCATION"),
+	)
+	for _, entry := range report {
+		switch {
+		case entry.Error != "":
+			fmt.Printf("%-10s %-30s %s\n", "", entry.Domain, theme.Fail.Render(entry.Error))
+		case len(entry.Forwards) == 0:
+			fmt.Printf("%-10s %-30s %s\n", "", entry.Domain, theme.Muted.Render("(no forwarding)"))
+		}
+		for _, f := range entry.Forwards {
+			fmt.Printf("%-10s %-30s %-5s %-14s %s\n", f.ID, recordLabel(f.Subdomain, entry.Domain), forwardStatus(f.Type), forwardOptions(f), f.Location)
+		}
+	}
+}
+
+var forwardAddCmd = &cobra.Command{
+	Use:   "add [domain] [location]",
+	Short: "Add a URL forward",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		domain := args[0]
+		typ, err := parseForwardType(forwardType)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(err.Error()))
+			os.Exit(ExitInvalid)
+		}
+		subdomain := forwardSubdomain
+		if subdomain == "@" {
+			subdomain = ""
+		}
+		f := porkbun.URLForward{
+			Subdomain:   porkbun.Subdomain(subdomain, domain),
+			Location:    args[1],
+			Type:        typ,
+			IncludePath: yesNoFlag(forwardIncludePath),
+			Wildcard:    yesNoFlag(forwardWildcard),
+		}
+		if err := porkbun.ValidateURLForward(f); err != nil {
+			fmt.Println(theme.Fail.Render(err.Error()))
+			os.Exit(exitCode(err))
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+		if err := client.AddURLForwardContext(cmd.Context(), domain, f); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error adding URL forward: %v", err)))
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Forwarding %s to %s (%s)", recordLabel(f.Subdomain, domain), f.Location, forwardStatus(f.Type))))
+	},
+}
+
+var forwardRmCmd = &cobra.Command{
+	Use:   "rm [domain] [id]",
+	Short: "Remove a URL forward by ID",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		domain, id := args[0], args[1]
+		if err := client.DeleteURLForwardContext(cmd.Context(), domain, id); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error removing URL forward: %v", err)))
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Removed URL forward %s from %s", id, domain)))
+	},
+}
+
+// parseForwardType accepts the HTTP status codes as well as Porkbun's names.
+func parseForwardType(v string) (string, error) {
+	switch strings.ToLower(v) {
+	case "301", porkbun.ForwardPermanent:
+		return porkbun.ForwardPermanent, nil
+	case "302", porkbun.ForwardTemporary:
+		return porkbun.ForwardTemporary, nil
+	}
+	return "", fmt.Errorf("--type must be 301 (permanent) or 302 (temporary), got %q", v)
+}
+
+func forwardStatus(typ string) string {
+	switch typ {
+	case porkbun.ForwardPermanent:
+		return "301"
+	case porkbun.ForwardTemporary:
+		return "302"
+	}
+	return typ
+}
+
+func forwardOptions(f porkbun.URLForward) string {
+	var opts []string
+	if f.IncludePath == "yes" {
+		opts = append(opts, "path")
+	}
+	if f.Wildcard == "yes" {
+		opts = append(opts, "wildcard")
+	}
+	if len(opts) == 0 {
+		return "-"
+	}
+	return strings.Join(opts, ",")
+}
+
+func yesNoFlag(b bool) string {
+	if b {
+		return "yes"
+	}
+	return "no"
+}
+
+func init() {
+	forwardListCmd.Flags().BoolVar(&forwardJSON, "json", false, "Output results in JSON format")
+	forwardListCmd.Flags().BoolVar(&forwardAllDomains, "all-domains", false, "Report forwarding for every domain in the account")
+
+	forwardAddCmd.Flags().StringVar(&forwardSubdomain, "subdomain", "", "Subdomain to forward (default: the domain itself)")
+	forwardAddCmd.Flags().StringVar(&forwardType, "type", "302", "Redirect type: 301 (permanent) or 302 (temporary)")
+	forwardAddCmd.Flags().BoolVar(&forwardIncludePath, "include-path", false, "Append the request path to the location")
+	forwardAddCmd.Flags().BoolVar(&forwardWildcard, "wildcard", false, "Also forward all subdomains")
+
+	forwardCmd.AddCommand(forwardListCmd)
+	forwardCmd.AddCommand(forwardAddCmd)
+	forwardCmd.AddCommand(forwardRmCmd)
+	rootCmd.AddCommand(forwardCmd)
+}
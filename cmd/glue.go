@@ -0,0 +1,183 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var glueJSON bool
+
+var glueCmd = &cobra.Command{
+	Use:     "glue",
+	Short:   "Manage glue records for your own nameservers",
+	GroupID: GroupManagement,
+	Long: `Glue records register the IP addresses of nameservers that live inside the domain they serve (for example ns1.aaie.cloud for aaie.cloud) with the registry, so resolvers can find them.
+
+Hosts can be given relative to the domain (ns1) or fully qualified (ns1.aaie.cloud). Each host takes one or more IPv4 and IPv6 addresses.`,
+	Example: `  # Show glue records
+  steamer glue list aaie.cloud
+
+  # Register ns1.aaie.cloud with an IPv4 and an IPv6 address
+  steamer glue create aaie.cloud ns1 192.0.2.53 2001:db8::53
+
+  # Replace its addresses
+  steamer glue update aaie.cloud ns1 192.0.2.54
+
+  # Remove it
+  steamer glue delete aaie.cloud ns1`,
+}
+
+var glueListCmd = &cobra.Command{
+	Use:         "list [domain]",
+	Annotations: readOnly,
+	Short:       "List glue records for a domain",
+	Args:        cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		domain := args[0]
+		hosts, err := client.GetGlueContext(cmd.Context(), domain)
+		if err != nil {
+			fmt.Printf("Error retrieving glue records for %s: %v\n", domain, err)
+			os.Exit(exitCode(err))
+		}
+
+		if glueJSON {
+			if hosts == nil {
+				hosts = []porkbun.GlueRecord{}
+			}
+			b, err := json.MarshalIndent(hosts, "", "  ")
+			if err != nil {
+				fmt.Printf("Error encoding JSON: %v\n", err)
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(string(b))
+			return
+		}
+
+		if len(hosts) == 0 {
+			fmt.Println(theme.Muted.Render(fmt.Sprintf("No glue records for %s", domain)))
+			return
+		}
+		fmt.Printf("%s %s %s\n",
+			theme.Accent.Render(fmt.Sprintf("%-30s", "HOST")),
+			theme.Accent.Render(fmt.Sprintf("%-30s", "IPV4")),
+			theme.Accent.Render("IPV6"),
+		)
+		for _, h := range hosts {
+			fmt.Printf("%-30s %-30s %s\n", h.Host, strings.Join(h.IPv4, ", "), strings.Join(h.IPv6, ", "))
+		}
+	},
+}
+
+var glueCreateCmd = &cobra.Command{
+	Use:   "create [domain] [host] [ips...]",
+	Short: "Create a glue record",
+	Args:  cobra.MinimumNArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		runGlueSet(cmd, args, false)
+	},
+}
+
+var glueUpdateCmd = &cobra.Command{
+	Use:   "update [domain] [host] [ips...]",
+	Short: "Replace the addresses of a glue record",
+	Args:  cobra.MinimumNArgs(3),
+	Run: func(cmd *cobra.Command, args []string) {
+		runGlueSet(cmd, args, true)
+	},
+}
+
+var glueDeleteCmd = &cobra.Command{
+	Use:   "delete [domain] [host]",
+	Short: "Delete a glue record",
+	Args:  cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		domain, host := args[0], glueHost(args[1], args[0])
+		if err := client.DeleteGlueContext(cmd.Context(), domain, host); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error deleting glue record: %v", err)))
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Deleted glue record for %s", recordLabel(host, domain))))
+	},
+}
+
+// runGlueSet creates or updates the glue record for args[1] with the
+// addresses in args[2:].
+func runGlueSet(cmd *cobra.Command, args []string, update bool) {
+	domain, host, ips := args[0], glueHost(args[1], args[0]), args[2:]
+	if err := porkbun.ValidateGlueIPs(ips); err != nil {
+		fmt.Println(theme.Fail.Render(err.Error()))
+		os.Exit(exitCode(err))
+	}
+
+	client, err := newClient(cmd.Context())
+	if err != nil {
+		fmt.Println(err)
+		os.Exit(exitCode(err))
+	}
+
+	verb, action := "Created", "creating"
+	if update {
+		verb, action = "Updated", "updating"
+		err = client.UpdateGlueContext(cmd.Context(), domain, host, ips)
+	} else {
+		err = client.CreateGlueContext(cmd.Context(), domain, host, ips)
+	}
+	if err != nil {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("Error %s glue record: %v", action, err)))
+		os.Exit(exitCode(err))
+	}
+	fmt.Println(theme.Pass.Render(fmt.Sprintf("%s glue record for %s -> %s", verb, recordLabel(host, domain), strings.Join(ips, ", "))))
+}
+
+// glueHost returns host relative to domain. Unlike DNS records, a glue
+// record can't be at the root of the domain.
+func glueHost(host, domain string) string {
+	sub := porkbun.Subdomain(host, domain)
+	if sub == "" || sub == "@" {
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("Glue records need a host under %s, such as ns1", domain)))
+		os.Exit(ExitInvalid)
+	}
+	return sub
+}
+
+func init() {
+	glueListCmd.Flags().BoolVar(&glueJSON, "json", false, "Output results in JSON format")
+	glueCmd.AddCommand(glueListCmd)
+	glueCmd.AddCommand(glueCreateCmd)
+	glueCmd.AddCommand(glueUpdateCmd)
+	glueCmd.AddCommand(glueDeleteCmd)
+	rootCmd.AddCommand(glueCmd)
+}
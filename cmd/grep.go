@@ -0,0 +1,203 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"regexp"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	grepTypes  []string
+	grepRegexp bool
+	grepJobs   int
+	grepJSON   bool
+)
+
+var grepCmd = &cobra.Command{
+	Use:     "grep [pattern]",
+	Short:   "Find records in every domain whose content matches a pattern",
+	GroupID: GroupInfo,
+	Long: `Searches the DNS records of every domain in the account for content matching the pattern, such as the IP address of a server being retired.
+
+By default the pattern is matched literally, ignoring case and a trailing dot, and must stand on its own: 192.0.2.1 matches "192.0.2.1" and "v=spf1 ip4:192.0.2.1 -all" but not 192.0.2.10, and example.com doesn't match mail.example.com. With --regexp the pattern is a Go regular expression matched anywhere in the content.
+
+Zones are fetched a few at a time (--jobs); rate-limited requests are retried like any other. Domains that can't be read are reported on stderr and the rest are still searched. The exit status is 0 if something matched, 1 if nothing did, and the usual error codes if a domain failed.`,
+	Example: `  # What still points at the old server?
+  steamer grep 192.0.2.1
+
+  # CNAMEs to a retired host
+  steamer grep old-lb.example.net --type CNAME
+
+  # Anything in the 192.0.2.0/24 range, as JSON
+  steamer grep --regexp '^192\.0\.2\.' --json`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		re, err := grepPattern(args[0], grepRegexp)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Invalid pattern: %v", err)))
+			os.Exit(ExitInvalid)
+		}
+		types := make([]string, len(grepTypes))
+		for i, t := range grepTypes {
+			types[i] = strings.ToUpper(t)
+		}
+		if grepJobs < 1 {
+			fmt.Println(theme.Fail.Render("--jobs must be at least 1"))
+			os.Exit(ExitInvalid)
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+		ctx := cmd.Context()
+
+		domains, err := client.ListDomainsContext(ctx)
+		if err != nil {
+			fmt.Printf("Error listing domains: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+		names := make([]string, len(domains))
+		for i, d := range domains {
+			names[i] = d.Domain
+		}
+
+		matches := []grepMatch{}
+		var firstErr error
+		for _, z := range fetchZones(ctx, client, names, grepJobs) {
+			if z.err != nil {
+				fmt.Fprintln(os.Stderr, theme.Fail.Render(fmt.Sprintf("%s: %v", z.domain, z.err)))
+				if firstErr == nil {
+					firstErr = z.err
+				}
+				continue
+			}
+			for _, r := range z.records {
+				if (len(types) == 0 || slices.Contains(types, r.Type)) && re.MatchString(r.Content) {
+					matches = append(matches, grepMatch{Domain: z.domain, DNSRecord: r})
+				}
+			}
+		}
+
+		if grepJSON {
+			b, err := json.MarshalIndent(matches, "", "  ")
+			if err != nil {
+				fmt.Printf("Error encoding JSON: %v\n", err)
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(string(b))
+		} else if len(matches) > 0 {
+			printGrepMatches(matches)
+		}
+
+		switch {
+		case firstErr != nil:
+			os.Exit(exitCode(firstErr))
+		case len(matches) == 0:
+			if !grepJSON {
+				fmt.Println(theme.Muted.Render(fmt.Sprintf("No records match %s in %d domains", args[0], len(names))))
+			}
+			os.Exit(1)
+		}
+	},
+}
+
+// grepMatch is a matching record tagged with its domain.
+type grepMatch struct {
+	Domain string `json:"domain"`
+	porkbun.DNSRecord
+}
+
+// grepPattern compiles the pattern. A literal pattern matches
+// case-insensitively with an optional trailing dot, and only where it isn't
+// part of a longer address or hostname.
+func grepPattern(pattern string, isRegexp bool) (*regexp.Regexp, error) {
+	if isRegexp {
+		return regexp.Compile(pattern)
+	}
+	literal := strings.TrimSuffix(pattern, ".")
+	if literal == "" {
+		return nil, errors.New("pattern is empty")
+	}
+	return regexp.Compile(`(?i)(?:^|[^\w.-])` + regexp.QuoteMeta(literal) + `\.?(?:$|[^\w.:-])`)
+}
+
+// zoneResult holds the records of one domain, or why they couldn't be read.
+type zoneResult struct {
+	domain  string
+	records []porkbun.DNSRecord
+	err     error
+}
+
+// fetchZones retrieves the records of every domain using at most jobs
+// concurrent requests. Results are returned in the order of domains.
+func fetchZones(ctx context.Context, client *porkbun.Client, domains []string, jobs int) []zoneResult {
+	results := make([]zoneResult, len(domains))
+	next := make(chan int)
+	var wg sync.WaitGroup
+	for range min(jobs, len(domains)) {
+		wg.Go(func() {
+			for i := range next {
+				records, err := client.RetrieveRecordsContext(ctx, domains[i])
+				results[i] = zoneResult{domain: domains[i], records: records, err: err}
+			}
+		})
+	}
+	for i := range domains {
+		next <- i
+	}
+	close(next)
+	wg.Wait()
+	return results
+}
+
+func printGrepMatches(matches []grepMatch) {
+	fmt.Printf("%s %s %s %s\n",
+		theme.Accent.Render(fmt.Sprintf("%-25s", "DOMAIN")),
+		theme.Accent.Render(fmt.Sprintf("%-30s", "NAME")),
+		theme.Accent.Render(fmt.Sprintf("%-10s", "TYPE")),
+		theme.Accent.Render("CONTENT"),
+	)
+	for _, m := range matches {
+		fmt.Printf("%s %s %s %s\n",
+			fmt.Sprintf("%-25s", m.Domain),
+			fmt.Sprintf("%-30s", m.Name),
+			theme.Muted.Render(fmt.Sprintf("%-10s", m.Type)),
+			m.Content,
+		)
+	}
+}
+
+func init() {
+	grepCmd.Flags().StringSliceVar(&grepTypes, "type", nil, "Only search records of these types (repeatable or comma-separated)")
+	grepCmd.Flags().BoolVarP(&grepRegexp, "regexp", "E", false, "Treat the pattern as a regular expression")
+	grepCmd.Flags().IntVar(&grepJobs, "jobs", 4, "Maximum number of zones fetched at once")
+	grepCmd.Flags().BoolVar(&grepJSON, "json", false, "Output matches as JSON")
+	rootCmd.AddCommand(grepCmd)
+}
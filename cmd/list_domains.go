@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"time"
 
 	"github.com/ghchinoy/steamer/internal/porkbun"
 	"github.com/ghchinoy/steamer/internal/theme"
@@ -25,64 +26,155 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var listDomainsJSON bool
+var (
+	listDomainsJSON        bool
+	listDomainsAllProfiles bool
+)
 
 var listDomainsCmd = &cobra.Command{
 	Use:     "list-domains",
 	Short:   "List all domains in your Porkbun account",
 	GroupID: GroupInfo,
-	Long:    `Retrieves and displays a list of all domains associated with your Porkbun account, including their current status and expiration dates.`,
+	Long:    `Retrieves and displays a list of all domains associated with your Porkbun account, including their current status and expiration dates. With --all-profiles, the domains of every configured account profile are listed together, tagged with their profile.`,
 	Example: `  # List domains in a table
   steamer list-domains
 
   # Output domains as JSON for scripting
-  steamer list-domains --json`,
+  steamer list-domains --json
+
+  # Domains across every account in the config file
+  steamer list-domains --all-profiles`,
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, secretKey, err := getClientConfig()
-		if err != nil {
-			fmt.Println(err)
-			os.Exit(1)
+		if listDomainsAllProfiles {
+			listDomainsAcrossProfiles(cmd)
+			return
 		}
 
-		client := porkbun.NewClient(apiKey, secretKey)
-		domains, err := client.ListDomains()
+		client, err := newClient(cmd.Context())
 		if err != nil {
-			fmt.Printf("Error listing domains: %v\n", err)
-			os.Exit(1)
+			fmt.Println(err)
+			os.Exit(exitCode(err))
 		}
 
 		if listDomainsJSON {
+			domains, err := client.ListDomainsContext(cmd.Context())
+			if err != nil {
+				fmt.Printf("Error listing domains: %v\n", err)
+				os.Exit(exitCode(err))
+			}
 			b, err := json.MarshalIndent(domains, "", "  ")
 			if err != nil {
 				fmt.Printf("Error encoding JSON: %v\n", err)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			fmt.Println(string(b))
 			return
 		}
 
-		fmt.Printf("%s %s %s %s\n",
-			theme.Accent.Render(fmt.Sprintf("%-25s", "DOMAIN")),
-			theme.Accent.Render(fmt.Sprintf("%-10s", "STATUS")),
-			theme.Accent.Render(fmt.Sprintf("%-10s", "TLD")),
-			theme.Accent.Render(fmt.Sprintf("%-20s", "EXPIRATION")),
-		)
-		for _, d := range domains {
-			statusColor := theme.Pass
-			if d.Status != "ACTIVE" {
-				statusColor = theme.Warn
+		// Stream the table so large accounts print as pages arrive.
+		printDomainHeader(false)
+		for d, err := range client.DomainsContext(cmd.Context()) {
+			if err != nil {
+				fmt.Printf("Error listing domains: %v\n", err)
+				os.Exit(exitCode(err))
 			}
-			fmt.Printf("%s %s %s %s\n",
-				fmt.Sprintf("%-25s", d.Domain),
-				statusColor.Render(fmt.Sprintf("%-10s", d.Status)),
-				theme.Muted.Render(fmt.Sprintf("%-10s", d.TLD)),
-				fmt.Sprintf("%-20s", d.ExpireDate),
-			)
+			printDomainRow("", d)
 		}
 	},
 }
 
+// profileDomain is a domain tagged with the profile it belongs to.
+type profileDomain struct {
+	Profile string `json:"profile"`
+	porkbun.Domain
+}
+
+// listDomainsAcrossProfiles lists the domains of every configured profile.
+// A profile that fails is reported on stderr and the others are still
+// listed; the exit status is then non-zero.
+func listDomainsAcrossProfiles(cmd *cobra.Command) {
+	profiles := profileNames()
+	if len(profiles) == 0 {
+		_, _, err := getClientConfig(cmd.Context(), defaultProfile)
+		fmt.Println(err)
+		os.Exit(exitCode(err))
+	}
+
+	all := []profileDomain{}
+	failed := false
+	if !listDomainsJSON {
+		printDomainHeader(true)
+	}
+	for _, profile := range profiles {
+		client, err := newProfileClient(cmd.Context(), profile)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, theme.Fail.Render(fmt.Sprintf("%s: %v", profile, err)))
+			failed = true
+			continue
+		}
+		for d, err := range client.DomainsContext(cmd.Context()) {
+			if err != nil {
+				fmt.Fprintln(os.Stderr, theme.Fail.Render(fmt.Sprintf("%s: error listing domains: %v", profile, err)))
+				failed = true
+				break
+			}
+			if listDomainsJSON {
+				all = append(all, profileDomain{Profile: profile, Domain: d})
+			} else {
+				printDomainRow(profile, d)
+			}
+		}
+	}
+
+	if listDomainsJSON {
+		b, err := json.MarshalIndent(all, "", "  ")
+		if err != nil {
+			fmt.Printf("Error encoding JSON: %v\n", err)
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(string(b))
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+func printDomainHeader(withProfile bool) {
+	if withProfile {
+		fmt.Print(theme.Accent.Render(fmt.Sprintf("%-15s", "PROFILE")) + " ")
+	}
+	fmt.Printf("%s %s %s %s\n",
+		theme.Accent.Render(fmt.Sprintf("%-25s", "DOMAIN")),
+		theme.Accent.Render(fmt.Sprintf("%-10s", "STATUS")),
+		theme.Accent.Render(fmt.Sprintf("%-10s", "TLD")),
+		theme.Accent.Render(fmt.Sprintf("%-20s", "EXPIRATION")),
+	)
+}
+
+// printDomainRow prints d as a table row, with a profile column unless
+// profile is empty.
+func printDomainRow(profile string, d porkbun.Domain) {
+	statusColor := theme.Pass
+	if d.Status != "ACTIVE" {
+		statusColor = theme.Warn
+	}
+	expires := "-"
+	if !d.ExpireDate.IsZero() {
+		expires = d.ExpireDate.Format(time.DateTime)
+	}
+	if profile != "" {
+		fmt.Print(theme.Muted.Render(fmt.Sprintf("%-15s", profile)) + " ")
+	}
+	fmt.Printf("%s %s %s %s\n",
+		fmt.Sprintf("%-25s", d.Domain),
+		statusColor.Render(fmt.Sprintf("%-10s", d.Status)),
+		theme.Muted.Render(fmt.Sprintf("%-10s", d.TLD)),
+		fmt.Sprintf("%-20s", expires),
+	)
+}
+
 func init() {
 	listDomainsCmd.Flags().BoolVar(&listDomainsJSON, "json", false, "Output results in JSON format")
+	listDomainsCmd.Flags().BoolVar(&listDomainsAllProfiles, "all-profiles", false, "List the domains of every configured profile")
 	rootCmd.AddCommand(listDomainsCmd)
 }
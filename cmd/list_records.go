@@ -18,6 +18,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"os"
+	"strconv"
 
 	"github.com/ghchinoy/steamer/internal/porkbun"
 	"github.com/ghchinoy/steamer/internal/theme"
@@ -25,62 +26,87 @@ import (
 	"github.com/spf13/cobra"
 )
 
-var listRecordsJSON bool
+var (
+	listRecordsJSON     bool
+	listRecordsSelector recordSelector
+)
 
 var listRecordsCmd = &cobra.Command{
 	Use:     "list-records [domain]",
 	Short:   "List DNS records for a specific domain",
 	GroupID: GroupInfo,
-	Long:    `Retrieves and displays all DNS records (A, CNAME, TXT, etc.) for the specified domain from your Porkbun account.`,
+	Long:    `Retrieves and displays all DNS records (A, CNAME, TXT, etc.) for the specified domain from your Porkbun account, with their TTL, priority and notes.`,
 	Example: `  # List records for aaie.cloud
   steamer list-records aaie.cloud
 
   # Output records as JSON
-  steamer list-records aaie.cloud --json`,
+  steamer list-records aaie.cloud --json
+
+  # Only the TXT records at the root
+  steamer list-records aaie.cloud --name @ --type TXT`,
 	Args: cobra.ExactArgs(1),
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, secretKey, err := getClientConfig()
+		client, err := newClient(cmd.Context())
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		domain := args[0]
-		client := porkbun.NewClient(apiKey, secretKey)
-		records, err := client.RetrieveRecords(domain)
+		records, err := listRecordsSelector.find(cmd.Context(), client, domain)
 		if err != nil {
 			fmt.Printf("Error retrieving records for %s: %v\n", domain, err)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if listRecordsJSON {
 			b, err := json.MarshalIndent(records, "", "  ")
 			if err != nil {
 				fmt.Printf("Error encoding JSON: %v\n", err)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			fmt.Println(string(b))
 			return
 		}
 
-		fmt.Printf("%s %s %s %s\n",
-			theme.Accent.Render(fmt.Sprintf("%-10s", "ID")),
-			theme.Accent.Render(fmt.Sprintf("%-25s", "NAME")),
-			theme.Accent.Render(fmt.Sprintf("%-10s", "TYPE")),
-			theme.Accent.Render(fmt.Sprintf("%-30s", "CONTENT")),
-		)
-		for _, r := range records {
-			fmt.Printf("%s %s %s %s\n",
-				theme.ID.Render(fmt.Sprintf("%-10v", r.ID)),
-				fmt.Sprintf("%-25s", r.Name),
-				theme.Muted.Render(fmt.Sprintf("%-10s", r.Type)),
-				fmt.Sprintf("%-30s", r.Content),
-			)
-		}
+		printRecordTable(records)
 	},
 }
 
+// printRecordTable prints records as the list-records table.
+func printRecordTable(records []porkbun.DNSRecord) {
+	fmt.Printf("%s %s %s %s %s %s %s\n",
+		theme.Accent.Render(fmt.Sprintf("%-10s", "ID")),
+		theme.Accent.Render(fmt.Sprintf("%-25s", "NAME")),
+		theme.Accent.Render(fmt.Sprintf("%-10s", "TYPE")),
+		theme.Accent.Render(fmt.Sprintf("%-6s", "TTL")),
+		theme.Accent.Render(fmt.Sprintf("%-5s", "PRIO")),
+		theme.Accent.Render(fmt.Sprintf("%-30s", "CONTENT")),
+		theme.Accent.Render("NOTES"),
+	)
+	for _, r := range records {
+		fmt.Printf("%s %s %s %s %s %s %s\n",
+			theme.ID.Render(fmt.Sprintf("%-10s", r.ID)),
+			fmt.Sprintf("%-25s", r.Name),
+			theme.Muted.Render(fmt.Sprintf("%-10s", r.Type)),
+			fmt.Sprintf("%-6d", r.TTL),
+			fmt.Sprintf("%-5s", prioLabel(r.Prio)),
+			fmt.Sprintf("%-30s", r.Content),
+			theme.Muted.Render(r.Notes),
+		)
+	}
+}
+
+// prioLabel formats a record priority for the table, showing 0 as "-".
+func prioLabel(prio int) string {
+	if prio == 0 {
+		return "-"
+	}
+	return strconv.Itoa(prio)
+}
+
 func init() {
 	listRecordsCmd.Flags().BoolVar(&listRecordsJSON, "json", false, "Output results in JSON format")
+	listRecordsSelector.addFlags(listRecordsCmd)
 	rootCmd.AddCommand(listRecordsCmd)
 }
@@ -1,12 +1,11 @@
 package cmd
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"os"
-	"path/filepath"
 	"sort"
-	"time"
 
 	"github.com/charmbracelet/bubbles/table"
 	tea "github.com/charmbracelet/bubbletea"
@@ -24,7 +23,7 @@ var listTldsCmd = &cobra.Command{
 	Use:     "list-tlds",
 	Short:   "List all supported TLDs and their pricing",
 	GroupID: GroupInfo,
-	Long:    `Retrieves and displays a list of all Top-Level Domains (TLDs) supported by Porkbun, along with their registration, renewal, and transfer prices. Results are cached locally for 7 days to improve performance.`,
+	Long:    `Retrieves and displays a list of all Top-Level Domains (TLDs) supported by Porkbun, along with their registration, renewal, and transfer prices. Prices are cached for 7 days; use --refresh (or --force) to fetch them again.`,
 	Example: `  # List all TLDs in a table
   steamer list-tlds
 
@@ -34,23 +33,27 @@ var listTldsCmd = &cobra.Command{
   # Output TLDs as JSON
   steamer list-tlds --json`,
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, secretKey, err := getClientConfig()
+		client, err := newClient(cmd.Context())
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
-		pricing, err := getCachedOrFetchPricing(apiKey, secretKey, listTldsForce)
+		if listTldsForce {
+			client.Cache.Refresh = true
+		}
+
+		pricing, err := fetchPricing(cmd.Context(), client)
 		if err != nil {
 			fmt.Printf("Error fetching TLD pricing: %v\n", err)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
 		if listTldsJSON {
 			b, err := json.MarshalIndent(pricing, "", "  ")
 			if err != nil {
 				fmt.Printf("Error encoding JSON: %v\n", err)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			fmt.Println(string(b))
 			return
@@ -103,57 +106,13 @@ var listTldsCmd = &cobra.Command{
 		m := tldTableModel{t}
 		if _, err := tea.NewProgram(m).Run(); err != nil {
 			fmt.Printf("Error running TUI: %v\n", err)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 	},
 }
 
-func getCachedOrFetchPricing(apiKey, secretKey string, force bool) (map[string]porkbun.TLDPricing, error) {
-	home, err := os.UserHomeDir()
-	if err != nil {
-		return fetchPricing(apiKey, secretKey)
-	}
-
-	cacheDir := filepath.Join(home, ".config", "steamer")
-	if err := os.MkdirAll(cacheDir, 0700); err != nil {
-		return fetchPricing(apiKey, secretKey)
-	}
-
-	cacheFile := filepath.Join(cacheDir, "tlds.json")
-
-	if !force {
-		info, err := os.Stat(cacheFile)
-		if err == nil {
-			// Cache exists, check if it's less than 7 days old
-			if time.Since(info.ModTime()) < 7*24*time.Hour {
-				data, err := os.ReadFile(cacheFile)
-				if err == nil {
-					var cached map[string]porkbun.TLDPricing
-					if err := json.Unmarshal(data, &cached); err == nil {
-						return cached, nil
-					}
-				}
-			}
-		}
-	}
-
-	// Fetch fresh pricing
-	pricing, err := fetchPricing(apiKey, secretKey)
-	if err != nil {
-		return nil, err
-	}
-
-	// Try to cache it, but don't fail if we can't
-	if data, err := json.Marshal(pricing); err == nil {
-		_ = os.WriteFile(cacheFile, data, 0600)
-	}
-
-	return pricing, nil
-}
-
-func fetchPricing(apiKey, secretKey string) (map[string]porkbun.TLDPricing, error) {
-	client := porkbun.NewClient(apiKey, secretKey)
-	res, err := client.GetPricing()
+func fetchPricing(ctx context.Context, client *porkbun.Client) (map[string]porkbun.TLDPricing, error) {
+	res, err := client.GetPricingContext(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -162,7 +121,7 @@ func fetchPricing(apiKey, secretKey string) (map[string]porkbun.TLDPricing, erro
 
 func init() {
 	listTldsCmd.Flags().BoolVar(&listTldsJSON, "json", false, "Output results in JSON format")
-	listTldsCmd.Flags().BoolVar(&listTldsForce, "force", false, "Force refresh the TLD cache")
+	listTldsCmd.Flags().BoolVar(&listTldsForce, "force", false, "Fetch prices again even if cached (same as --refresh)")
 	rootCmd.AddCommand(listTldsCmd)
 }
 
@@ -0,0 +1,179 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	nsJSON        bool
+	nsAutoApprove bool
+)
+
+var nsCmd = &cobra.Command{
+	Use:     "ns",
+	Short:   "Show or change a domain's nameservers",
+	GroupID: GroupManagement,
+}
+
+var nsGetCmd = &cobra.Command{
+	Use:         "get [domain]",
+	Annotations: readOnly,
+	Short:       "Show the nameservers registered for a domain",
+	Example: `  # Show the nameservers for aaie.cloud
+  steamer ns get aaie.cloud`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		domain := args[0]
+		ns, err := client.GetNameserversContext(cmd.Context(), domain)
+		if err != nil {
+			fmt.Printf("Error retrieving nameservers for %s: %v\n", domain, err)
+			os.Exit(exitCode(err))
+		}
+
+		if nsJSON {
+			if ns == nil {
+				ns = []string{}
+			}
+			b, err := json.MarshalIndent(ns, "", "  ")
+			if err != nil {
+				fmt.Printf("Error encoding JSON: %v\n", err)
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(string(b))
+			return
+		}
+
+		for _, host := range ns {
+			fmt.Println(host)
+		}
+	},
+}
+
+var nsSetCmd = &cobra.Command{
+	Use:   "set [domain] [ns...]",
+	Short: "Replace the nameservers registered for a domain",
+	Long: `Replaces the domain's nameservers at the registry. The current and new nameservers are shown side by side and the change has to be confirmed.
+
+Porkbun only serves the DNS records managed by steamer (list-records, add, apply, ...) while the domain uses Porkbun's nameservers, so moving away from them takes those records offline once the change propagates.`,
+	Example: `  # Delegate aaie.cloud to Cloudflare
+  steamer ns set aaie.cloud ada.ns.cloudflare.com bob.ns.cloudflare.com
+
+  # Go back to Porkbun's nameservers without prompting
+  steamer ns set aaie.cloud curitiba.ns.porkbun.com fortaleza.ns.porkbun.com maceio.ns.porkbun.com salvador.ns.porkbun.com --auto-approve`,
+	Args: cobra.MinimumNArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		domain := args[0]
+		desired := make([]string, 0, len(args)-1)
+		for _, host := range args[1:] {
+			desired = append(desired, strings.TrimSuffix(strings.ToLower(host), "."))
+		}
+		if err := porkbun.ValidateNameservers(desired); err != nil {
+			fmt.Println(theme.Fail.Render(err.Error()))
+			os.Exit(exitCode(err))
+		}
+
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		current, err := client.GetNameserversContext(cmd.Context(), domain)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error retrieving nameservers for %s: %v", domain, err)))
+			os.Exit(exitCode(err))
+		}
+		if sameNameservers(current, desired) {
+			fmt.Println(theme.Muted.Render(fmt.Sprintf("%s already uses these nameservers", domain)))
+			return
+		}
+
+		fmt.Printf("%s %s\n",
+			theme.Accent.Render(fmt.Sprintf("%-35s", "CURRENT")),
+			theme.Accent.Render("NEW"),
+		)
+		for i := range max(len(current), len(desired)) {
+			var from, to string
+			if i < len(current) {
+				from = current[i]
+			}
+			if i < len(desired) {
+				to = desired[i]
+			}
+			fmt.Printf("%-35s %s\n", from, to)
+		}
+		fmt.Println()
+
+		if usesPorkbun(current) && !usesPorkbun(desired) {
+			fmt.Println(theme.Warn.Render(fmt.Sprintf("⚠️  %s is moving away from Porkbun's nameservers. The records shown by 'steamer list-records %s' will stop being served once the change propagates; recreate them at the new DNS provider first.", domain, domain)))
+			fmt.Println()
+		}
+
+		if !nsAutoApprove && !confirm(fmt.Sprintf("Update nameservers for %s?", domain)) {
+			fmt.Println(theme.Muted.Render("Nameservers not changed."))
+			return
+		}
+
+		if err := client.UpdateNameserversContext(cmd.Context(), domain, desired); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error updating nameservers: %v", err)))
+			os.Exit(exitCode(err))
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Updated nameservers for %s", domain)))
+	},
+}
+
+// usesPorkbun reports whether any of the nameservers is one of Porkbun's.
+func usesPorkbun(ns []string) bool {
+	return slices.ContainsFunc(ns, porkbun.IsPorkbunNameserver)
+}
+
+// sameNameservers compares two nameserver lists, ignoring order, case and
+// trailing dots.
+func sameNameservers(a, b []string) bool {
+	normalize := func(ns []string) []string {
+		out := make([]string, len(ns))
+		for i, host := range ns {
+			out[i] = strings.TrimSuffix(strings.ToLower(host), ".")
+		}
+		slices.Sort(out)
+		return out
+	}
+	return slices.Equal(normalize(a), normalize(b))
+}
+
+func init() {
+	nsGetCmd.Flags().BoolVar(&nsJSON, "json", false, "Output results in JSON format")
+	nsSetCmd.Flags().BoolVar(&nsAutoApprove, "auto-approve", false, "Update the nameservers without asking for confirmation")
+	nsCmd.AddCommand(nsGetCmd)
+	nsCmd.AddCommand(nsSetCmd)
+	rootCmd.AddCommand(nsCmd)
+}
@@ -0,0 +1,130 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/ghchinoy/steamer/internal/plan"
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+var planPrune bool
+
+var planCmd = &cobra.Command{
+	Use:     "plan [zone-file]",
+	Short:   "Show the changes needed to match a zone file",
+	GroupID: GroupManagement,
+	Long: `Reads a declarative YAML zone file, compares it with the live DNS records at Porkbun, and prints the records that would be created, updated or deleted. Nothing is changed; use 'apply' to make the changes.
+
+Records that exist at Porkbun but not in the file are left alone unless --prune is passed.`,
+	Example: `  # Preview the changes for a zone file
+  steamer plan zones/aaie.cloud.yaml
+
+  # Include deletions of records missing from the file
+  steamer plan zones/aaie.cloud.yaml --prune`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		zone, changes, err := computePlan(cmd.Context(), client, args[0], planPrune)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(err.Error()))
+			os.Exit(exitCode(err))
+		}
+
+		printPlan(zone.Domain, changes)
+	},
+}
+
+// computePlan loads a zone file and diffs it against the live records.
+func computePlan(ctx context.Context, client *porkbun.Client, path string, prune bool) (*plan.Zone, []plan.Change, error) {
+	zone, err := plan.Load(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	records, err := client.RetrieveRecordsContext(ctx, zone.Domain)
+	if err != nil {
+		return nil, nil, fmt.Errorf("error retrieving records for %s: %w", zone.Domain, err)
+	}
+	return zone, plan.Diff(zone.Domain, zone.Entries(), records, prune), nil
+}
+
+// printPlan renders a plan as a colored diff followed by a summary line.
+func printPlan(domain string, changes []plan.Change) {
+	fmt.Printf("%s %s\n\n", theme.Accent.Render("Plan for"), domain)
+	if len(changes) == 0 {
+		fmt.Println(theme.Pass.Render("No changes. Live records match the zone file."))
+		return
+	}
+
+	var creates, updates, deletes int
+	for _, c := range changes {
+		name := c.Name
+		if name == "" {
+			name = "@"
+		}
+		switch c.Action {
+		case plan.Create:
+			creates++
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("  + %-25s %-6s %s", name, c.Type, c.Desired.Content)) +
+				theme.Muted.Render(describeSettings(c.Desired)))
+		case plan.Update:
+			updates++
+			line := fmt.Sprintf("  ~ %-25s %-6s %s", name, c.Type, c.Current.Content)
+			if c.Current.Content != c.Desired.Content {
+				line += " -> " + c.Desired.Content
+			}
+			fmt.Println(theme.Warn.Render(line) + theme.Muted.Render(describeSettings(c.Desired)) +
+				" " + theme.ID.Render(fmt.Sprintf("(ID: %s)", c.Current.ID)))
+		case plan.Delete:
+			deletes++
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("  - %-25s %-6s %s", name, c.Type, c.Current.Content)) +
+				" " + theme.ID.Render(fmt.Sprintf("(ID: %s)", c.Current.ID)))
+		}
+	}
+	fmt.Printf("\n%s %s to create, %s to update, %s to delete.\n",
+		theme.Accent.Render("Plan:"),
+		theme.Pass.Render(strconv.Itoa(creates)),
+		theme.Warn.Render(strconv.Itoa(updates)),
+		theme.Fail.Render(strconv.Itoa(deletes)),
+	)
+}
+
+func describeSettings(r *plan.Record) string {
+	s := fmt.Sprintf(" ttl=%d", r.TTL)
+	if r.Prio != 0 {
+		s += fmt.Sprintf(" prio=%d", r.Prio)
+	}
+	if r.Notes != nil {
+		s += fmt.Sprintf(" notes=%q", *r.Notes)
+	}
+	return s
+}
+
+func init() {
+	planCmd.Flags().BoolVar(&planPrune, "prune", false, "Delete live records that are not declared in the zone file")
+	rootCmd.AddCommand(planCmd)
+}
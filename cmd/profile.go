@@ -0,0 +1,273 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+)
+
+// defaultProfile names the credentials set at the top level of the config
+// file (or through PORKBUN_APIKEY and friends), used when no profile is
+// selected and the profiles map doesn't define one by that name.
+const defaultProfile = "default"
+
+var profileJSON bool
+
+var profileCmd = &cobra.Command{
+	Use:     "profile",
+	Short:   "Manage named Porkbun account profiles",
+	GroupID: GroupConfig,
+	Long: `Profiles hold the API keys for different Porkbun accounts under the profiles key of config.yaml:
+
+  profile: personal        # the profile used when none is selected
+  profiles:
+    personal:
+      apikey: pk1_...
+      secretapikey: sk1_...
+    acme-corp:
+      apikey: pk1_...
+      secretapikey: sk1_...
+
+Instead of apikey and secretapikey, a profile can use apikey_command and secret_command, or keys stored with 'steamer auth login'.
+
+Every command uses the profile given with --profile, then STEAMER_PROFILE, then the profile key in the config file. Keys at the top level of the config file, in .env or in PORKBUN_APIKEY/PORKBUN_SECRETAPIKEY form the "default" profile. Profile names are not case sensitive.`,
+	Example: `  # See which profiles are configured
+  steamer profile list
+
+  # Switch to the acme-corp account for subsequent commands
+  steamer profile use acme-corp
+
+  # Run a single command against another account
+  steamer list-domains --profile personal`,
+}
+
+// profileInfo is the JSON form of a profile. Keys are always masked.
+type profileInfo struct {
+	Name      string `json:"name"`
+	Active    bool   `json:"active"`
+	APIKey    string `json:"apikey"`
+	SecretKey string `json:"secretapikey"`
+	Error     string `json:"error,omitempty"`
+}
+
+// describeProfile summarizes a profile. With fetch, keys held by commands
+// and stores are looked up; otherwise only where they come from is shown.
+func describeProfile(ctx context.Context, name string, fetch bool) profileInfo {
+	info := profileInfo{Name: name, Active: name == activeProfile()}
+	rc, err := resolveCredentials(ctx, name, fetch)
+	if err != nil {
+		info.Error = err.Error()
+	}
+	info.APIKey = describeKey(rc.APIKey, rc.APIKeySource)
+	info.SecretKey = describeKey(rc.SecretKey, rc.SecretSource)
+	return info
+}
+
+// describeKey masks key, naming its source when it isn't the config file.
+func describeKey(key, source string) string {
+	switch {
+	case source == "" || source == "config":
+		return maskSecret(key)
+	case key == "":
+		return "(" + source + ")"
+	}
+	return maskSecret(key) + " (" + source + ")"
+}
+
+var profileListCmd = &cobra.Command{
+	Use:   "list",
+	Short: "List the configured profiles",
+	Args:  cobra.NoArgs,
+	Run: func(cmd *cobra.Command, args []string) {
+		infos := []profileInfo{}
+		for _, name := range profileNames() {
+			infos = append(infos, describeProfile(cmd.Context(), name, false))
+		}
+
+		if profileJSON {
+			b, err := json.MarshalIndent(infos, "", "  ")
+			if err != nil {
+				fmt.Printf("Error encoding JSON: %v\n", err)
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(string(b))
+			return
+		}
+
+		if len(infos) == 0 {
+			fmt.Println(theme.Muted.Render("No profiles configured. Add apikey and secretapikey to ~/.config/steamer/config.yaml, or a profiles map."))
+			return
+		}
+		fmt.Printf("  %s %s\n",
+			theme.Accent.Render(fmt.Sprintf("%-20s", "PROFILE")),
+			theme.Accent.Render("API KEY"),
+		)
+		for _, info := range infos {
+			marker := " "
+			if info.Active {
+				marker = theme.Pass.Render("*")
+			}
+			key := info.APIKey
+			if info.Error != "" {
+				key = theme.Fail.Render(info.Error)
+			}
+			fmt.Printf("%s %-20s %s\n", marker, info.Name, key)
+		}
+	},
+}
+
+var profileShowCmd = &cobra.Command{
+	Use:   "show [profile]",
+	Short: "Show the active profile, or the given one",
+	Args:  cobra.MaximumNArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := activeProfile()
+		if len(args) == 1 {
+			name = strings.ToLower(args[0])
+		}
+		if !slices.Contains(profileNames(), name) {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Profile %q is not configured (available: %s)", name, strings.Join(profileNames(), ", "))))
+			os.Exit(ExitNotFound)
+		}
+		info := describeProfile(cmd.Context(), name, true)
+
+		if profileJSON {
+			b, err := json.MarshalIndent(info, "", "  ")
+			if err != nil {
+				fmt.Printf("Error encoding JSON: %v\n", err)
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(string(b))
+			return
+		}
+
+		title := info.Name
+		if info.Active {
+			title += " " + theme.Pass.Render("(active)")
+		}
+		fmt.Printf("%-12s %s\n", "Profile:", title)
+		fmt.Printf("%-12s %s\n", "API key:", info.APIKey)
+		fmt.Printf("%-12s %s\n", "Secret key:", info.SecretKey)
+		if info.Error != "" {
+			fmt.Println(theme.Fail.Render(info.Error))
+			os.Exit(1)
+		}
+	},
+}
+
+var profileUseCmd = &cobra.Command{
+	Use:   "use [profile]",
+	Short: "Make a profile the default for subsequent commands",
+	Long:  `Records the profile in the profile key of the config file. --profile and STEAMER_PROFILE still take precedence over it.`,
+	Args:  cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		name := strings.ToLower(args[0])
+		if !slices.Contains(profileNames(), name) {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Profile %q is not configured (available: %s)", name, strings.Join(profileNames(), ", "))))
+			os.Exit(ExitNotFound)
+		}
+
+		path, err := configFilePath()
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error locating config file: %v", err)))
+			os.Exit(1)
+		}
+		if err := setConfigValue(path, name, "profile"); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error updating %s: %v", path, err)))
+			os.Exit(1)
+		}
+		fmt.Println(theme.Pass.Render(fmt.Sprintf("Now using profile %s (saved to %s)", name, path)))
+		if env := os.Getenv("STEAMER_PROFILE"); env != "" && !strings.EqualFold(env, name) {
+			fmt.Println(theme.Warn.Render(fmt.Sprintf("⚠️  STEAMER_PROFILE=%s is set and takes precedence in this shell.", env)))
+		}
+	},
+}
+
+// activeProfile returns the selected profile: --profile, then
+// STEAMER_PROFILE, then the profile key in the config file, falling back to
+// the default profile.
+func activeProfile() string {
+	if name := strings.ToLower(viper.GetString("profile")); name != "" {
+		return name
+	}
+	return defaultProfile
+}
+
+// profileNames lists the configured profiles in name order, with the
+// default profile first when top-level credentials are set.
+func profileNames() []string {
+	var names []string
+	for name := range viper.GetStringMap("profiles") {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	if !slices.Contains(names, defaultProfile) {
+		apiKey, secretKey := credentialsFrom(viper.GetString)
+		if apiKey != "" || secretKey != "" || viper.GetString("store") != "" || viper.GetString("apikey_command") != "" {
+			names = append([]string{defaultProfile}, names...)
+		}
+	}
+	return names
+}
+
+// credentialsFrom reads the API key and secret with get, accepting the
+// alternative key names older configs and .env files use.
+func credentialsFrom(get func(string) string) (string, string) {
+	first := func(keys ...string) string {
+		for _, key := range keys {
+			if v := get(key); v != "" {
+				return v
+			}
+		}
+		return ""
+	}
+	return first("apikey", "api_key"), first("secretapikey", "api_secret", "apisecret", "secretkey")
+}
+
+// maskSecret shows only the pk1_/sk1_ prefix and last four characters of a
+// key, so the right key can be recognized without revealing it. Keys too
+// short to keep most of them hidden that way are masked entirely.
+func maskSecret(s string) string {
+	if s == "" {
+		return "(not set)"
+	}
+	if len(s) < 16 {
+		return strings.Repeat("*", len(s))
+	}
+	prefix := ""
+	if p, _, ok := strings.Cut(s, "_"); ok && (p == "pk1" || p == "sk1") {
+		prefix = p + "_"
+	}
+	return prefix + "…" + s[len(s)-4:]
+}
+
+func init() {
+	profileListCmd.Flags().BoolVar(&profileJSON, "json", false, "Output results in JSON format")
+	profileShowCmd.Flags().BoolVar(&profileJSON, "json", false, "Output results in JSON format")
+	profileCmd.AddCommand(profileListCmd)
+	profileCmd.AddCommand(profileShowCmd)
+	profileCmd.AddCommand(profileUseCmd)
+	rootCmd.AddCommand(profileCmd)
+}
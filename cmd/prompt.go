@@ -0,0 +1,40 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/theme"
+)
+
+// stdinReader is shared by everything that reads answers from stdin, so
+// that successive prompts don't lose each other's buffered input.
+var stdinReader = bufio.NewReader(os.Stdin)
+
+// confirm asks a yes/no question on stdin and reports whether the user
+// answered yes. Anything other than "y" or "yes" counts as no.
+func confirm(question string) bool {
+	fmt.Printf("%s %s ", theme.Warn.Render(question), theme.Muted.Render("[y/N]"))
+	answer, err := stdinReader.ReadString('\n')
+	if err != nil {
+		return false
+	}
+	answer = strings.ToLower(strings.TrimSpace(answer))
+	return answer == "y" || answer == "yes"
+}
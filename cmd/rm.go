@@ -18,40 +18,91 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/ghchinoy/steamer/internal/porkbun"
 	"github.com/ghchinoy/steamer/internal/theme"
 	"github.com/spf13/cobra"
 )
 
+var (
+	rmSelector recordSelector
+	rmAll      bool
+)
+
 var rmCmd = &cobra.Command{
 	Use:     "rm [domain] [record-id]",
-	Short:   "Remove a DNS record from a domain using its ID",
+	Short:   "Remove DNS records by ID, or by name and type",
 	GroupID: GroupManagement,
-	Long:    `Deletes a specific DNS record from your Porkbun domain. You must provide the exact record ID, which can be found using the 'list-records' command.`,
+	Long: `Deletes DNS records from your Porkbun domain. Either pass the exact record ID, which can be found using the 'list-records' command, or select records with --name and/or --type.
+
+When a selection matches more than one record, nothing is deleted unless --all is given.`,
 	Example: `  # Delete record ID 123456789 from aaie.cloud
-  steamer rm aaie.cloud 123456789`,
-	Args: cobra.ExactArgs(2),
+  steamer rm aaie.cloud 123456789
+
+  # Delete the CNAME record for www.aaie.cloud
+  steamer rm aaie.cloud --name www --type CNAME
+
+  # Delete every TXT record at the root
+  steamer rm aaie.cloud --name @ --type TXT --all`,
+	Args: selectorArgs(&rmSelector),
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, secretKey, err := getClientConfig()
+		client, err := newClient(cmd.Context())
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
+		ctx := cmd.Context()
 		domain := args[0]
-		id := args[1]
 
-		client := porkbun.NewClient(apiKey, secretKey)
-		err = client.DeleteRecord(domain, id)
+		if !rmSelector.set() {
+			id := args[1]
+			err = client.DeleteRecordContext(ctx, domain, id)
+			if err != nil {
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("Error deleting record: %v", err)))
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully deleted record %s from %s", id, domain)))
+			return
+		}
+
+		matches, err := rmSelector.find(ctx, client, domain)
 		if err != nil {
-			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error deleting record: %v", err)))
-			os.Exit(1)
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error retrieving records for %s: %v", domain, err)))
+			os.Exit(exitCode(err))
+		}
+		if len(matches) == 0 {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("No %s", rmSelector.describe(domain))))
+			os.Exit(ExitNotFound)
+		}
+		if len(matches) > 1 && !rmAll {
+			printRecordTable(matches)
+			fmt.Println()
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("%d %s match; refusing to delete more than one record without --all", len(matches), rmSelector.describe(domain))))
+			os.Exit(ExitInvalid)
 		}
 
-		fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully deleted record %s from %s", id, domain)))
+		if rmSelector.exact() && rmAll {
+			err := client.DeleteRecordsByNameTypeContext(ctx, domain, rmSelector.typ, rmSelector.subdomain(domain))
+			if err != nil {
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("Error deleting records: %v", err)))
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully deleted %d %s", len(matches), rmSelector.describe(domain))))
+			return
+		}
+
+		for _, r := range matches {
+			id := r.ID
+			if err := client.DeleteRecordContext(ctx, domain, id); err != nil {
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("Error deleting record %s: %v", id, err)))
+				os.Exit(exitCode(err))
+			}
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("Successfully deleted %s record %s (%s) from %s", r.Type, id, r.Name, domain)))
+		}
 	},
 }
 
 func init() {
+	rmSelector.addFlags(rmCmd)
+	rmCmd.Flags().BoolVar(&rmAll, "all", false, "Delete every record matching --name/--type")
 	rootCmd.AddCommand(rmCmd)
 }
@@ -16,16 +16,34 @@
 package cmd
 
 import (
-	"fmt"
+	"context"
 	"os"
+	"os/signal"
 	"path/filepath"
+	"time"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
 
 	"github.com/joho/godotenv"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 )
 
-var cfgFile string
+var (
+	cfgFile        string
+	requestTimeout time.Duration
+	maxRetries     int
+	offline        bool
+	refreshCache   bool
+	// cacheReads is set for commands that only browse, which may be served
+	// from the response cache. Commands that change records always read
+	// fresh data unless --offline is given.
+	cacheReads bool
+)
+
+// interruptGracePeriod is how long a command may take to wind down after
+// Ctrl-C before the process is terminated.
+const interruptGracePeriod = 2 * time.Second
 
 const (
 	// GroupInfo is for commands that retrieve and display data.
@@ -34,18 +52,43 @@ const (
 	GroupManagement = "management"
 	// GroupTUI is for interactive terminal interface commands.
 	GroupTUI = "tui"
+	// GroupConfig is for commands that manage steamer's own configuration.
+	GroupConfig = "config"
 )
 
+// annotationCacheReads marks a command outside GroupInfo and GroupTUI, such
+// as a "list" subcommand of a management command, as only browsing, so it
+// may be served from the response cache.
+const annotationCacheReads = "steamer:cache-reads"
+
+// readOnly is the Annotations value for commands that only browse.
+var readOnly = map[string]string{annotationCacheReads: "true"}
+
 var rootCmd = &cobra.Command{
 	Use:   "steamer",
 	Short: "A CLI for managing Porkbun domains",
 	Long:  `steamer is a CLI tool built with Go, Cobra, and Bubble Tea to manage your Porkbun domains and DNS records.`,
+	PersistentPreRun: func(cmd *cobra.Command, args []string) {
+		cacheReads = cmd.GroupID == GroupInfo || cmd.GroupID == GroupTUI || cmd.Annotations[annotationCacheReads] == "true"
+	},
 }
 
 // Execute adds all child commands to the root command and sets flags appropriately.
 // This is called by main.main(). It only needs to happen once to the rootCmd.
+// Interrupting the process cancels the command's context, which aborts any
+// in-flight API request; a command that is blocked elsewhere (such as on a
+// prompt) gets a short grace period before the process exits.
 func Execute() {
-	err := rootCmd.Execute()
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+	go func() {
+		<-ctx.Done()
+		stop()
+		time.Sleep(interruptGracePeriod)
+		os.Exit(130)
+	}()
+
+	err := rootCmd.ExecuteContext(ctx)
 	if err != nil {
 		os.Exit(1)
 	}
@@ -66,13 +109,47 @@ func init() {
 		ID:    GroupTUI,
 		Title: "Interactive Commands:",
 	})
+	rootCmd.AddGroup(&cobra.Group{
+		ID:    GroupConfig,
+		Title: "Configuration Commands:",
+	})
 
 	rootCmd.PersistentFlags().StringVar(&cfgFile, "config", "", "config file (default is $HOME/.config/steamer/config.yaml)")
+	rootCmd.PersistentFlags().DurationVar(&requestTimeout, "timeout", porkbun.DefaultTimeout, "maximum time to wait for each Porkbun API request")
+	rootCmd.PersistentFlags().String("api-endpoint", "", "Porkbun API base URL (default "+porkbun.DefaultBaseURL+")")
+	_ = viper.BindPFlag("api_endpoint", rootCmd.PersistentFlags().Lookup("api-endpoint"))
+	rootCmd.PersistentFlags().String("profile", "", "account profile to use from the config file (default from STEAMER_PROFILE or the profile key)")
+	_ = viper.BindPFlag("profile", rootCmd.PersistentFlags().Lookup("profile"))
+	_ = viper.BindEnv("profile", "STEAMER_PROFILE")
+	rootCmd.PersistentFlags().BoolVar(&offline, "offline", false, "serve everything from the response cache and never contact Porkbun")
+	rootCmd.PersistentFlags().BoolVar(&refreshCache, "refresh", false, "ignore cached responses and fetch fresh ones")
+	rootCmd.PersistentFlags().IntVar(&maxRetries, "retries", porkbun.DefaultRetryPolicy.MaxRetries, "how many times to retry failed requests that are safe to repeat (0 disables retries)")
 
 	viper.SetDefault("apikey", "")
 	viper.SetDefault("secretapikey", "")
 }
 
+// configNames are the config file names searched for, in order, each with
+// any extension viper supports.
+var configNames = []string{"config", "steamer"}
+
+// configSearchDirs returns the directories searched for the config file,
+// in priority order.
+func configSearchDirs() []string {
+	home, _ := os.UserHomeDir()
+
+	// Priority 1: ~/.config/steamer (XDG standard, common on macOS for CLI)
+	dirs := []string{filepath.Join(home, ".config", "steamer")}
+
+	// Priority 2: ~/Library/Application Support/steamer (macOS standard)
+	if configHome, err := os.UserConfigDir(); err == nil && filepath.Join(configHome, "steamer") != dirs[0] {
+		dirs = append(dirs, filepath.Join(configHome, "steamer"))
+	}
+
+	// Priority 3: $HOME (Legacy/simple)
+	return append(dirs, home)
+}
+
 func initConfig() {
 	// 1. Try loading .env file
 	_ = godotenv.Load()
@@ -81,26 +158,16 @@ func initConfig() {
 		viper.SetConfigFile(cfgFile)
 	} else {
 		// 2. Setup XDG-style config path
-		home, _ := os.UserHomeDir()
-
-		// Priority 1: ~/.config/steamer (XDG standard, common on macOS for CLI)
-		viper.AddConfigPath(filepath.Join(home, ".config", "steamer"))
-
-		// Priority 2: ~/Library/Application Support/steamer (macOS standard)
-		configHome, err := os.UserConfigDir()
-		if err == nil {
-			viper.AddConfigPath(filepath.Join(configHome, "steamer"))
+		for _, dir := range configSearchDirs() {
+			viper.AddConfigPath(dir)
 		}
 
-		// Priority 3: $HOME (Legacy/simple)
-		viper.AddConfigPath(home)
-
 		viper.SetConfigType("yaml")
 
 		// Try 'config' first (idiomatic), then 'steamer' (fallback)
-		viper.SetConfigName("config")
+		viper.SetConfigName(configNames[0])
 		if err := viper.ReadInConfig(); err != nil {
-			viper.SetConfigName("steamer")
+			viper.SetConfigName(configNames[1])
 		}
 	}
 
@@ -119,26 +186,54 @@ func initConfig() {
 	_ = viper.ReadInConfig()
 }
 
-func getClientConfig() (string, string, error) {
-	apiKey := viper.GetString("apikey")
-	if apiKey == "" {
-		apiKey = viper.GetString("api_key")
+// getClientConfig returns the API key and secret of the named profile,
+// wherever they are kept. Cancelling ctx stops any credential command.
+func getClientConfig(ctx context.Context, profile string) (string, string, error) {
+	rc, err := resolveCredentials(ctx, profile, true)
+	if err != nil {
+		return "", "", err
 	}
+	return rc.APIKey, rc.SecretKey, nil
+}
 
-	secretKey := viper.GetString("secretapikey")
-	if secretKey == "" {
-		secretKey = viper.GetString("api_secret")
-	}
-	if secretKey == "" {
-		secretKey = viper.GetString("apisecret")
-	}
-	if secretKey == "" {
-		secretKey = viper.GetString("secretkey")
+// newClient builds a Porkbun client from the active profile's credentials,
+// applying the --timeout, --retries and --api-endpoint flags.
+func newClient(ctx context.Context) (*porkbun.Client, error) {
+	return newProfileClient(ctx, activeProfile())
+}
+
+// newProfileClient is like newClient but uses the named profile.
+func newProfileClient(ctx context.Context, profile string) (*porkbun.Client, error) {
+	apiKey, secretKey, err := getClientConfig(ctx, profile)
+	if err != nil {
+		return nil, err
 	}
+	return clientWithKeys(apiKey, secretKey), nil
+}
 
-	if apiKey == "" || secretKey == "" {
-		return "", "", fmt.Errorf("porkbun API key and secret must be provided via config file (~/.config/steamer/config.yaml), .env, or environment variables - hint: create a config file at ~/.config/steamer/config.yaml or set PORKBUN_APIKEY and PORKBUN_SECRETAPIKEY environment variables")
+// clientWithKeys builds a Porkbun client for the given keys, applying the
+// global flags.
+func clientWithKeys(apiKey, secretKey string) *porkbun.Client {
+	var opts []porkbun.Option
+	if endpoint := viper.GetString("api_endpoint"); endpoint != "" {
+		opts = append(opts, porkbun.WithBaseURL(endpoint))
 	}
+	client := porkbun.NewClient(apiKey, secretKey, opts...)
+	client.HTTPClient.Timeout = requestTimeout
+	client.Retry.MaxRetries = maxRetries
+	client.Cache = responseCache()
+	return client
+}
 
-	return apiKey, secretKey, nil
+// responseCache returns the cache for API responses, kept under the user's
+// cache directory (~/.cache/steamer on Linux).
+func responseCache() *porkbun.Cache {
+	var dir string
+	if cacheDir, err := os.UserCacheDir(); err == nil {
+		dir = filepath.Join(cacheDir, "steamer")
+	}
+	cache := porkbun.NewCache(dir)
+	cache.Offline = offline
+	cache.Refresh = refreshCache || !cacheReads
+	return cache
 }
@@ -49,14 +49,12 @@ var searchCmd = &cobra.Command{
   steamer search mynewidea.com --json`,
 	Run: func(cmd *cobra.Command, args []string) {
 		query := args[0]
-		apiKey, secretKey, err := getClientConfig()
+		client, err := newClient(cmd.Context())
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
-		client := porkbun.NewClient(apiKey, secretKey)
-
 		// Determine if it's a phrase or a specific domain
 		var domainsToCheck []string
 		if strings.Contains(query, ".") {
@@ -81,9 +79,13 @@ var searchCmd = &cobra.Command{
 				if !searchJSON {
 					fmt.Printf("%s Waiting 10s for Porkbun rate limits...\n", theme.Warn.Render("⏳"))
 				}
-				time.Sleep(10 * time.Second)
+				select {
+				case <-time.After(10 * time.Second):
+				case <-cmd.Context().Done():
+					os.Exit(130)
+				}
 			}
-			res, err := client.CheckDomain(d)
+			res, err := client.CheckDomainContext(cmd.Context(), d)
 			finalResults = append(finalResults, struct {
 				Domain string
 				Res    *porkbun.DomainCheckResponse
@@ -95,7 +97,7 @@ var searchCmd = &cobra.Command{
 			b, err := json.MarshalIndent(finalResults, "", "  ")
 			if err != nil {
 				fmt.Printf("Error encoding JSON: %v\n", err)
-				os.Exit(1)
+				os.Exit(exitCode(err))
 			}
 			fmt.Println(string(b))
 			return
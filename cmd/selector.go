@@ -0,0 +1,109 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+
+	"github.com/spf13/cobra"
+)
+
+// recordSelector picks DNS records by name and type, for commands that
+// would otherwise need a record ID.
+type recordSelector struct {
+	// name is relative to the domain or fully qualified; "@" selects the
+	// root. Empty matches any name.
+	name string
+	// typ is the record type. Empty matches any type.
+	typ string
+}
+
+func (s *recordSelector) addFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&s.name, "name", "", `Select records by name (subdomain, or "@" for the root)`)
+	cmd.Flags().StringVar(&s.typ, "type", "", "Select records by type (A, CNAME, TXT, ...)")
+}
+
+// set reports whether any selector flag was given.
+func (s *recordSelector) set() bool {
+	return s.name != "" || s.typ != ""
+}
+
+// exact reports whether both name and type are given, so the *ByNameType
+// endpoints can be used.
+func (s *recordSelector) exact() bool {
+	return s.name != "" && s.typ != ""
+}
+
+func (s *recordSelector) subdomain(domain string) string {
+	if s.name == "@" {
+		return ""
+	}
+	return porkbun.Subdomain(s.name, domain)
+}
+
+// find returns the records on domain that match the selector.
+func (s *recordSelector) find(ctx context.Context, client *porkbun.Client, domain string) ([]porkbun.DNSRecord, error) {
+	if s.exact() {
+		return client.RetrieveRecordsByNameTypeContext(ctx, domain, s.typ, s.subdomain(domain))
+	}
+	records, err := client.RetrieveRecordsContext(ctx, domain)
+	if err != nil {
+		return nil, err
+	}
+	matches := []porkbun.DNSRecord{}
+	for _, r := range records {
+		if s.typ != "" && !strings.EqualFold(r.Type, s.typ) {
+			continue
+		}
+		if s.name != "" && porkbun.Subdomain(r.Name, domain) != s.subdomain(domain) {
+			continue
+		}
+		matches = append(matches, r)
+	}
+	return matches, nil
+}
+
+// describe returns a phrase like "A records at www.aaie.cloud".
+func (s *recordSelector) describe(domain string) string {
+	what := "records"
+	if s.typ != "" {
+		what = strings.ToUpper(s.typ) + " records"
+	}
+	if s.name != "" {
+		return fmt.Sprintf("%s at %s", what, recordLabel(s.subdomain(domain), domain))
+	}
+	return fmt.Sprintf("%s on %s", what, domain)
+}
+
+// selectorArgs accepts [domain] when the selector is set and [domain]
+// [record-id] otherwise.
+func selectorArgs(s *recordSelector) cobra.PositionalArgs {
+	return func(cmd *cobra.Command, args []string) error {
+		if s.set() {
+			if len(args) == 2 {
+				return fmt.Errorf("pass either a record ID or --name/--type, not both")
+			}
+			return cobra.ExactArgs(1)(cmd, args)
+		}
+		if len(args) == 1 {
+			return fmt.Errorf("pass a record ID or select records with --name and --type")
+		}
+		return cobra.ExactArgs(2)(cmd, args)
+	}
+}
@@ -0,0 +1,293 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"bytes"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/ghchinoy/steamer/internal/theme"
+
+	"github.com/spf13/cobra"
+)
+
+// sslExpiryWarning is how close to expiry a certificate gets flagged.
+const sslExpiryWarning = 14 * 24 * time.Hour
+
+var (
+	sslDir      string
+	sslPostHook string
+)
+
+var sslCmd = &cobra.Command{
+	Use:     "ssl",
+	Short:   "Retrieve the free SSL certificate Porkbun issues for a domain",
+	GroupID: GroupManagement,
+}
+
+var sslFetchCmd = &cobra.Command{
+	Use:   "fetch [domain]",
+	Short: "Download the SSL bundle and install it into a directory",
+	Long: `Downloads the certificate chain and keys Porkbun issued for the domain and writes them to --dir:
+
+  fullchain.pem  certificate chain (0644)
+  privkey.pem    private key (0600)
+  public.pem     public key (0644)
+
+All files are written to temporary files first and then renamed into place together, so a web server never reads a half-written certificate or a key that doesn't match it; if one can't be installed, the others are put back as they were. Files whose contents haven't changed are left alone, and --post-hook only runs when something was updated, which makes the command safe to run from cron. The hook runs through sh -c with STEAMER_DOMAIN and STEAMER_SSL_DIR set.`,
+	Example: `  # Install into /etc/ssl/aaie.cloud and reload nginx when the certificate changes
+  steamer ssl fetch aaie.cloud --dir /etc/ssl/aaie.cloud --post-hook "systemctl reload nginx"`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		domain := args[0]
+		bundle, err := client.RetrieveSSLBundleContext(cmd.Context(), domain)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error retrieving SSL bundle for %s: %v", domain, err)))
+			os.Exit(exitCode(err))
+		}
+
+		leaf, err := parseLeafCertificate(bundle.CertificateChain)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Porkbun returned an unusable certificate chain: %v", err)))
+			os.Exit(1)
+		}
+		if bundle.PrivateKey == "" {
+			fmt.Println(theme.Fail.Render("Porkbun returned no private key"))
+			os.Exit(1)
+		}
+		describeCertificate(leaf)
+
+		if err := os.MkdirAll(sslDir, 0700); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error creating %s: %v", sslDir, err)))
+			os.Exit(1)
+		}
+		var files []fileUpdate
+		for _, f := range []struct {
+			name    string
+			content string
+			perm    fs.FileMode
+		}{
+			{"fullchain.pem", bundle.CertificateChain, 0644},
+			{"privkey.pem", bundle.PrivateKey, 0600},
+			{"public.pem", bundle.PublicKey, 0644},
+		} {
+			if f.content != "" {
+				files = append(files, fileUpdate{filepath.Join(sslDir, f.name), []byte(ensureNewline(f.content)), f.perm})
+			}
+		}
+		// The certificate and key must match, so they are replaced as a set.
+		updated, err := installFiles(files)
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error installing the SSL bundle in %s, nothing was changed: %v", sslDir, err)))
+			os.Exit(1)
+		}
+		changed := false
+		for i, f := range files {
+			if updated[i] {
+				changed = true
+				fmt.Println(theme.Pass.Render(fmt.Sprintf("Wrote %s", f.path)))
+			} else {
+				fmt.Println(theme.Muted.Render(fmt.Sprintf("%s is up to date", f.path)))
+			}
+		}
+
+		if !changed || sslPostHook == "" {
+			return
+		}
+		fmt.Println(theme.Muted.Render(fmt.Sprintf("Running post hook: %s", sslPostHook)))
+		hook := exec.CommandContext(cmd.Context(), "sh", "-c", sslPostHook)
+		hook.Stdout = os.Stdout
+		hook.Stderr = os.Stderr
+		hook.Env = append(os.Environ(), "STEAMER_DOMAIN="+domain, "STEAMER_SSL_DIR="+sslDir)
+		if err := hook.Run(); err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Post hook failed: %v", err)))
+			os.Exit(1)
+		}
+	},
+}
+
+// parseLeafCertificate returns the first certificate in a PEM chain.
+func parseLeafCertificate(chain string) (*x509.Certificate, error) {
+	rest := []byte(chain)
+	for {
+		var block *pem.Block
+		block, rest = pem.Decode(rest)
+		if block == nil {
+			return nil, errors.New("no certificate found")
+		}
+		if block.Type == "CERTIFICATE" {
+			return x509.ParseCertificate(block.Bytes)
+		}
+	}
+}
+
+func describeCertificate(cert *x509.Certificate) {
+	left := time.Until(cert.NotAfter)
+	expiry := fmt.Sprintf("Certificate expires %s (%d days)", cert.NotAfter.Format("2006-01-02 15:04 MST"), int(left.Hours()/24))
+	switch {
+	case left <= 0:
+		fmt.Println(theme.Fail.Render(fmt.Sprintf("Certificate expired %s", cert.NotAfter.Format("2006-01-02 15:04 MST"))))
+	case left < sslExpiryWarning:
+		fmt.Println(theme.Warn.Render(expiry))
+	default:
+		fmt.Println(theme.Pass.Render(expiry))
+	}
+	fmt.Println(theme.Muted.Render(fmt.Sprintf("Names: %s", strings.Join(cert.DNSNames, ", "))))
+}
+
+// installFile atomically replaces path with data unless it already holds
+// exactly that, and makes sure it has the given permissions either way. It
+// reports whether the contents changed.
+func installFile(path string, data []byte, perm fs.FileMode) (bool, error) {
+	updated, err := installFiles([]fileUpdate{{path, data, perm}})
+	if err != nil {
+		return false, err
+	}
+	return updated[0], nil
+}
+
+// fileUpdate is a file for installFiles to write.
+type fileUpdate struct {
+	path string
+	data []byte
+	perm fs.FileMode
+}
+
+// installFiles is like installFile for a set of files that must change
+// together, such as a certificate and its key. Every new file is written
+// to a temporary file before any is renamed into place, and if a rename
+// fails the files already replaced get their old contents back. It reports
+// which files changed.
+func installFiles(files []fileUpdate) ([]bool, error) {
+	staged := make([]string, len(files))
+	previous := make([][]byte, len(files))
+	defer func() {
+		for _, tmp := range staged {
+			if tmp != "" {
+				_ = os.Remove(tmp)
+			}
+		}
+	}()
+
+	for i, f := range files {
+		current, err := os.ReadFile(f.path)
+		switch {
+		case err == nil && bytes.Equal(current, f.data):
+			if err := os.Chmod(f.path, f.perm); err != nil {
+				return nil, err
+			}
+			continue
+		case err == nil:
+			previous[i] = current
+		case !errors.Is(err, fs.ErrNotExist):
+			return nil, err
+		}
+		if staged[i], err = stageFile(f.path, f.data, f.perm); err != nil {
+			return nil, err
+		}
+	}
+
+	updated := make([]bool, len(files))
+	for i, f := range files {
+		if staged[i] == "" {
+			continue
+		}
+		if err := os.Rename(staged[i], f.path); err != nil {
+			return nil, errors.Join(err, restoreFiles(files[:i], updated[:i], previous))
+		}
+		staged[i] = ""
+		updated[i] = true
+	}
+	return updated, nil
+}
+
+// restoreFiles undoes the renames installFiles made, putting back the
+// previous contents of the files that were replaced and removing the ones
+// that were created.
+func restoreFiles(files []fileUpdate, updated []bool, previous [][]byte) error {
+	var errs []error
+	for i, f := range files {
+		if !updated[i] {
+			continue
+		}
+		if previous[i] == nil {
+			errs = append(errs, os.Remove(f.path))
+			continue
+		}
+		tmp, err := stageFile(f.path, previous[i], f.perm)
+		if err == nil {
+			err = os.Rename(tmp, f.path)
+		}
+		if err != nil {
+			_ = os.Remove(tmp)
+			errs = append(errs, fmt.Errorf("restoring %s: %w", f.path, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// stageFile writes data to a temporary file next to path and returns its
+// name, ready to be renamed over path.
+func stageFile(path string, data []byte, perm fs.FileMode) (string, error) {
+	tmp, err := os.CreateTemp(filepath.Dir(path), "."+filepath.Base(path)+".*")
+	if err != nil {
+		return "", err
+	}
+	// Set permissions before writing so the key is never readable by others.
+	err = tmp.Chmod(perm)
+	if err == nil {
+		_, err = tmp.Write(data)
+	}
+	if err == nil {
+		err = tmp.Sync()
+	}
+	if closeErr := tmp.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+		return "", err
+	}
+	return tmp.Name(), nil
+}
+
+func ensureNewline(s string) string {
+	if strings.HasSuffix(s, "\n") {
+		return s
+	}
+	return s + "\n"
+}
+
+func init() {
+	sslFetchCmd.Flags().StringVar(&sslDir, "dir", ".", "Directory to write fullchain.pem, privkey.pem and public.pem to")
+	sslFetchCmd.Flags().StringVar(&sslPostHook, "post-hook", "", "Command to run (via sh -c) when any file changed")
+	sslCmd.AddCommand(sslFetchCmd)
+	rootCmd.AddCommand(sslCmd)
+}
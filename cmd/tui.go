@@ -18,7 +18,6 @@ import (
 	"fmt"
 	"os"
 
-	"github.com/ghchinoy/steamer/internal/porkbun"
 	"github.com/ghchinoy/steamer/internal/tui"
 
 	tea "github.com/charmbracelet/bubbletea"
@@ -38,18 +37,16 @@ var tuiCmd = &cobra.Command{
   # Start the TUI directly focused on a specific domain
   steamer tui -d aaie.cloud`,
 	Run: func(cmd *cobra.Command, args []string) {
-		apiKey, secretKey, err := getClientConfig()
+		client, err := newClient(cmd.Context())
 		if err != nil {
 			fmt.Println(err)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 
-		client := porkbun.NewClient(apiKey, secretKey)
-
-		p := tea.NewProgram(tui.NewModel(client, domainFlag))
+		p := tea.NewProgram(tui.NewModel(cmd.Context(), client, domainFlag), tea.WithContext(cmd.Context()))
 		if _, err := p.Run(); err != nil {
 			fmt.Printf("Error running TUI: %v\n", err)
-			os.Exit(1)
+			os.Exit(exitCode(err))
 		}
 	},
 }
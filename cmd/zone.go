@@ -0,0 +1,246 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package cmd
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/theme"
+	"github.com/ghchinoy/steamer/internal/zonefile"
+
+	"github.com/spf13/cobra"
+)
+
+var (
+	zoneExportOutput string
+	zoneImportDryRun bool
+)
+
+var zoneCmd = &cobra.Command{
+	Use:     "zone",
+	Short:   "Import and export BIND zone files",
+	GroupID: GroupManagement,
+	Long:    `Converts between a domain's Porkbun DNS records and RFC 1035 master files ("BIND zone files"), the format most registrars and DNS hosts can export.`,
+}
+
+var zoneExportCmd = &cobra.Command{
+	Use:   "export [domain]",
+	Short: "Write a domain's DNS records as a BIND zone file",
+	Long:  `Retrieves all DNS records for the domain and writes them as an RFC 1035 master file with $ORIGIN and $TTL directives, owner names relative to the domain, and MX/SRV priorities in the record data.`,
+	Example: `  # Print the zone for aaie.cloud
+  steamer zone export aaie.cloud
+
+  # Save it to a file
+  steamer zone export aaie.cloud -o aaie.cloud.zone`,
+	Args: cobra.ExactArgs(1),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		domain := args[0]
+		records, err := client.RetrieveRecordsContext(cmd.Context(), domain)
+		if err != nil {
+			fmt.Printf("Error retrieving records for %s: %v\n", domain, err)
+			os.Exit(exitCode(err))
+		}
+
+		zone := make([]zonefile.Record, 0, len(records))
+		aliases := 0
+		for _, r := range records {
+			if r.Type == "ALIAS" {
+				aliases++
+			}
+			zone = append(zone, zonefile.Record{
+				Name:    porkbun.Subdomain(r.Name, domain),
+				Type:    r.Type,
+				Content: r.Content,
+				TTL:     r.TTL,
+				Prio:    r.Prio,
+			})
+		}
+
+		var out io.Writer = os.Stdout
+		if zoneExportOutput != "" {
+			f, err := os.Create(zoneExportOutput)
+			if err != nil {
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("Error creating %s: %v", zoneExportOutput, err)))
+				os.Exit(exitCode(err))
+			}
+			out = f
+		}
+
+		err = zonefile.Write(out, domain, zone)
+		if f, ok := out.(*os.File); ok && f != os.Stdout {
+			err = errors.Join(err, f.Close())
+		}
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error writing zone file: %v", err)))
+			os.Exit(exitCode(err))
+		}
+		if aliases > 0 {
+			fmt.Fprintln(os.Stderr, theme.Warn.Render(fmt.Sprintf("%d ALIAS records were written as comments; ALIAS isn't standard DNS and must be recreated by hand (e.g. as CNAME or A records) elsewhere", aliases)))
+		}
+		if zoneExportOutput != "" {
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("Wrote %d records for %s to %s", len(zone), domain, zoneExportOutput)))
+		}
+	},
+}
+
+var zoneImportCmd = &cobra.Command{
+	Use:   "import [domain] [zone-file]",
+	Short: "Create DNS records from a BIND zone file",
+	Long: `Parses an RFC 1035 master file and creates every record that doesn't already exist on the Porkbun domain. Existing records are never changed or deleted.
+
+SOA records and NS records at the zone apex are skipped, since Porkbun manages those itself. Every record to be created is checked first, including Porkbun's minimum TTL of 600 seconds; if any fails, nothing is created.`,
+	Example: `  # See what would be created
+  steamer zone import aaie.cloud aaie.cloud.zone --dry-run
+
+  # Create the missing records
+  steamer zone import aaie.cloud aaie.cloud.zone`,
+	Args: cobra.ExactArgs(2),
+	Run: func(cmd *cobra.Command, args []string) {
+		client, err := newClient(cmd.Context())
+		if err != nil {
+			fmt.Println(err)
+			os.Exit(exitCode(err))
+		}
+
+		domain := args[0]
+		f, err := os.Open(args[1])
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error opening zone file: %v", err)))
+			os.Exit(exitCode(err))
+		}
+		parsed, err := zonefile.Parse(f, domain)
+		_ = f.Close()
+		if err != nil {
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("Error parsing %s: %v", args[1], err)))
+			os.Exit(exitCode(err))
+		}
+
+		existing, err := client.RetrieveRecordsContext(cmd.Context(), domain)
+		if err != nil {
+			fmt.Printf("Error retrieving records for %s: %v\n", domain, err)
+			os.Exit(exitCode(err))
+		}
+		have := make(map[string]bool, len(existing))
+		for _, r := range existing {
+			have[recordKey(porkbun.Subdomain(r.Name, domain), r.Type, r.Content)] = true
+		}
+
+		var pending []zonefile.Record
+		skipped := 0
+		for _, r := range parsed {
+			label := recordLabel(r.Name, domain)
+			if r.Type == "NS" && r.Name == "" {
+				skipped++
+				fmt.Println(theme.Muted.Render(fmt.Sprintf("  skip   %-6s %s %s (apex NS is managed by Porkbun)", r.Type, label, r.Content)))
+				continue
+			}
+			key := recordKey(r.Name, r.Type, r.Content)
+			if have[key] {
+				skipped++
+				fmt.Println(theme.Muted.Render(fmt.Sprintf("  exists %-6s %s %s", r.Type, label, r.Content)))
+				continue
+			}
+			have[key] = true
+			pending = append(pending, r)
+		}
+
+		// Check everything before creating anything, so a bad record
+		// doesn't leave the import half done.
+		invalid := 0
+		for _, r := range pending {
+			err := porkbun.ValidateContent(r.Type, r.Content)
+			if err == nil && r.TTL != 0 {
+				err = porkbun.ValidateTTL(r.TTL)
+			}
+			if err != nil {
+				invalid++
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("  invalid %-6s %s %s: %v", r.Type, recordLabel(r.Name, domain), r.Content, err)))
+			}
+		}
+		if invalid > 0 {
+			fmt.Println()
+			fmt.Println(theme.Fail.Render(fmt.Sprintf("%d records in %s can't be created on Porkbun; fix them and run the import again. Nothing was created.", invalid, args[1])))
+			os.Exit(ExitInvalid)
+		}
+
+		created, failed := 0, 0
+		for _, r := range pending {
+			label := recordLabel(r.Name, domain)
+			if zoneImportDryRun {
+				created++
+				fmt.Println(theme.Pass.Render(fmt.Sprintf("  + %-6s %s %s", r.Type, label, r.Content)))
+				continue
+			}
+
+			req := porkbun.CreateRecordRequest{
+				Name:    r.Name,
+				Type:    r.Type,
+				Content: r.Content,
+			}
+			if r.TTL != 0 {
+				req.TTL = strconv.Itoa(r.TTL)
+			}
+			if r.Type == "MX" || r.Type == "SRV" {
+				req.Prio = strconv.Itoa(r.Prio)
+			}
+			id, err := client.CreateRecordContext(cmd.Context(), domain, req)
+			if err != nil {
+				failed++
+				fmt.Println(theme.Fail.Render(fmt.Sprintf("  error  %-6s %s %s: %v", r.Type, label, r.Content, err)))
+				continue
+			}
+			created++
+			fmt.Println(theme.Pass.Render(fmt.Sprintf("  + %-6s %s %s", r.Type, label, r.Content)) + " " + theme.ID.Render(fmt.Sprintf("(ID: %s)", id)))
+		}
+
+		verb := "Created"
+		if zoneImportDryRun {
+			verb = "Would create"
+		}
+		fmt.Printf("\n%s %d records, skipped %d", verb, created, skipped)
+		if failed > 0 {
+			fmt.Printf(", %s\n", theme.Fail.Render(fmt.Sprintf("%d failed", failed)))
+			os.Exit(1)
+		}
+		fmt.Println(".")
+	},
+}
+
+// recordKey identifies a record by name, type and content. Names are
+// compared without case, and content the way porkbun.SameContent compares
+// it, so zone file and API spellings of the same hostname are equal.
+func recordKey(name, typ, content string) string {
+	return strings.ToLower(name) + "|" + strings.ToUpper(typ) + "|" + porkbun.NormalizeContent(typ, content)
+}
+
+func init() {
+	zoneExportCmd.Flags().StringVarP(&zoneExportOutput, "output", "o", "", "Write the zone file here instead of stdout")
+	zoneImportCmd.Flags().BoolVar(&zoneImportDryRun, "dry-run", false, "Show the records that would be created without creating them")
+	zoneCmd.AddCommand(zoneExportCmd)
+	zoneCmd.AddCommand(zoneImportCmd)
+	rootCmd.AddCommand(zoneCmd)
+}
@@ -0,0 +1,133 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package credentials keeps Porkbun API keys out of the plaintext config
+// file: in the OS keyring, in a passphrase-encrypted file, or behind a
+// command such as a password manager's CLI.
+package credentials
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/zalando/go-keyring"
+)
+
+// Credentials is a Porkbun API key pair.
+type Credentials struct {
+	APIKey       string `json:"apikey"`
+	SecretAPIKey string `json:"secretapikey"`
+}
+
+// ErrNotFound is returned by a Store that holds nothing for a profile.
+var ErrNotFound = errors.New("no stored credentials")
+
+// Store keeps credentials by profile name.
+type Store interface {
+	// Name identifies the store in messages and in the store config key.
+	Name() string
+	Get(profile string) (Credentials, error)
+	Set(profile string, c Credentials) error
+	Delete(profile string) error
+}
+
+// Names of the stores, as used in the store config key.
+const (
+	KeyringStore = "keyring"
+	FileStore    = "file"
+)
+
+// Keyring stores credentials in the OS keyring: the Secret Service (GNOME
+// Keyring, KWallet) on Linux, the Keychain on macOS and the Credential
+// Manager on Windows. Each profile is one entry holding both keys.
+type Keyring struct {
+	// Service is the keyring service name entries are filed under.
+	Service string
+}
+
+// Name implements Store.
+func (k Keyring) Name() string { return KeyringStore }
+
+// Get implements Store.
+func (k Keyring) Get(profile string) (Credentials, error) {
+	secret, err := keyring.Get(k.Service, profile)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return Credentials{}, ErrNotFound
+	}
+	if err != nil {
+		return Credentials{}, fmt.Errorf("reading keyring: %w", err)
+	}
+	var c Credentials
+	if err := json.Unmarshal([]byte(secret), &c); err != nil {
+		return Credentials{}, fmt.Errorf("keyring entry %s/%s is not valid: %w", k.Service, profile, err)
+	}
+	return c, nil
+}
+
+// Set implements Store.
+func (k Keyring) Set(profile string, c Credentials) error {
+	b, err := json.Marshal(c)
+	if err != nil {
+		return err
+	}
+	if err := keyring.Set(k.Service, profile, string(b)); err != nil {
+		return fmt.Errorf("writing keyring: %w", err)
+	}
+	return nil
+}
+
+// Delete implements Store.
+func (k Keyring) Delete(profile string) error {
+	err := keyring.Delete(k.Service, profile)
+	if errors.Is(err, keyring.ErrNotFound) {
+		return ErrNotFound
+	}
+	if err != nil {
+		return fmt.Errorf("deleting keyring entry: %w", err)
+	}
+	return nil
+}
+
+// Command runs command with sh -c and returns the first line of its standard
+// output with surrounding whitespace removed, for config such as
+// "apikey_command: pass show porkbun/apikey". Like pass, password managers
+// keep the secret on the first line and anything after it (usernames, URLs,
+// notes) is ignored.
+func Command(ctx context.Context, command string) (string, error) {
+	var stdout, stderr bytes.Buffer
+	cmd := exec.CommandContext(ctx, "sh", "-c", command)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	// Don't wait for children of the shell that keep its output open
+	// after ctx is cancelled.
+	cmd.WaitDelay = 100 * time.Millisecond
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return "", fmt.Errorf("%q: %w: %s", command, err, msg)
+		}
+		return "", fmt.Errorf("%q: %w", command, err)
+	}
+	out, _, _ := strings.Cut(strings.TrimSpace(stdout.String()), "\n")
+	out = strings.TrimSpace(out)
+	if out == "" {
+		return "", fmt.Errorf("%q printed nothing", command)
+	}
+	return out, nil
+}
@@ -0,0 +1,44 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"context"
+	"testing"
+)
+
+func TestCommand(t *testing.T) {
+	tests := []struct {
+		command string
+		want    string
+	}{
+		{"echo pk1_abc", "pk1_abc"},
+		{"printf '  pk1_abc  \\n'", "pk1_abc"},
+		{"printf 'pk1_abc\\nlogin: me\\nurl: porkbun.com\\n'", "pk1_abc"},
+		{"printf '\\npk1_abc\\n'", "pk1_abc"},
+	}
+	for _, tt := range tests {
+		got, err := Command(context.Background(), tt.command)
+		if err != nil || got != tt.want {
+			t.Errorf("Command(%q) = %q, %v; want %q", tt.command, got, err, tt.want)
+		}
+	}
+
+	for _, command := range []string{"true", "echo; echo", "echo oops >&2; exit 3"} {
+		if got, err := Command(context.Background(), command); err == nil {
+			t.Errorf("Command(%q) = %q, want an error", command, got)
+		}
+	}
+}
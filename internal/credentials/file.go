@@ -0,0 +1,270 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"golang.org/x/crypto/scrypt"
+)
+
+// fileVersion is the version of the encrypted file format.
+const fileVersion = 1
+
+// scrypt parameters for new files, as recommended for interactive logins.
+const (
+	scryptN = 1 << 15
+	scryptR = 8
+	scryptP = 1
+)
+
+// Limits on the scrypt parameters read from a file, so a tampered file
+// can't make steamer spend minutes or gigabytes deriving the key. scrypt
+// needs 128*N*r bytes of memory.
+const (
+	minScryptN      = 1 << 10
+	maxScryptN      = 1 << 20
+	maxScryptR      = 32
+	maxScryptP      = 16
+	maxScryptMemory = 256 << 20
+)
+
+// ErrWrongPassphrase is returned when a credentials file can't be
+// decrypted, which almost always means the passphrase is wrong.
+var ErrWrongPassphrase = errors.New("wrong passphrase or corrupted credentials file")
+
+// File stores the credentials of all profiles in one file, encrypted with
+// AES-256-GCM under a key derived from a passphrase with scrypt. The file
+// is JSON holding the scrypt parameters, salt, nonce and ciphertext, and is
+// rewritten with a fresh salt and nonce on every change.
+type File struct {
+	Path string
+	// Passphrase returns the passphrase. It is called at most once per File
+	// and is told whether the file is being created, so it can ask for
+	// confirmation.
+	Passphrase func(create bool) ([]byte, error)
+
+	passphrase []byte
+}
+
+// encryptedFile is the on-disk format of a File.
+type encryptedFile struct {
+	Version    int    `json:"version"`
+	N          int    `json:"n"`
+	R          int    `json:"r"`
+	P          int    `json:"p"`
+	Salt       []byte `json:"salt"`
+	Nonce      []byte `json:"nonce"`
+	Ciphertext []byte `json:"ciphertext"`
+}
+
+// Name implements Store.
+func (f *File) Name() string { return FileStore }
+
+// Exists reports whether the file has been created.
+func (f *File) Exists() bool {
+	_, err := os.Stat(f.Path)
+	return err == nil
+}
+
+// Get implements Store.
+func (f *File) Get(profile string) (Credentials, error) {
+	all, err := f.load()
+	if err != nil {
+		return Credentials{}, err
+	}
+	c, ok := all[profile]
+	if !ok {
+		return Credentials{}, ErrNotFound
+	}
+	return c, nil
+}
+
+// Set implements Store.
+func (f *File) Set(profile string, c Credentials) error {
+	all, err := f.load()
+	if err != nil && !errors.Is(err, ErrNotFound) {
+		return err
+	}
+	if all == nil {
+		all = map[string]Credentials{}
+	}
+	all[profile] = c
+	return f.save(all)
+}
+
+// Delete implements Store. The file is removed when its last profile is.
+func (f *File) Delete(profile string) error {
+	all, err := f.load()
+	if err != nil {
+		return err
+	}
+	if _, ok := all[profile]; !ok {
+		return ErrNotFound
+	}
+	delete(all, profile)
+	if len(all) == 0 {
+		return os.Remove(f.Path)
+	}
+	return f.save(all)
+}
+
+// load decrypts the file. A missing file is ErrNotFound.
+func (f *File) load() (map[string]Credentials, error) {
+	data, err := os.ReadFile(f.Path)
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil, ErrNotFound
+	}
+	if err != nil {
+		return nil, err
+	}
+	var ef encryptedFile
+	if err := json.Unmarshal(data, &ef); err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Path, err)
+	}
+	if ef.Version != fileVersion {
+		return nil, fmt.Errorf("%s: unsupported version %d", f.Path, ef.Version)
+	}
+	if err := checkScryptParams(ef.N, ef.R, ef.P); err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Path, err)
+	}
+	passphrase, err := f.getPassphrase(false)
+	if err != nil {
+		return nil, err
+	}
+	aead, err := newAEAD(passphrase, ef.Salt, ef.N, ef.R, ef.P)
+	if err != nil {
+		return nil, err
+	}
+	if len(ef.Nonce) != aead.NonceSize() {
+		return nil, ErrWrongPassphrase
+	}
+	plaintext, err := aead.Open(nil, ef.Nonce, ef.Ciphertext, nil)
+	if err != nil {
+		return nil, ErrWrongPassphrase
+	}
+	var all map[string]Credentials
+	if err := json.Unmarshal(plaintext, &all); err != nil {
+		return nil, fmt.Errorf("%s: %w", f.Path, err)
+	}
+	return all, nil
+}
+
+// save encrypts all and writes it to the file, readable only by the owner.
+func (f *File) save(all map[string]Credentials) error {
+	plaintext, err := json.Marshal(all)
+	if err != nil {
+		return err
+	}
+	passphrase, err := f.getPassphrase(!f.Exists())
+	if err != nil {
+		return err
+	}
+	ef := encryptedFile{Version: fileVersion, N: scryptN, R: scryptR, P: scryptP}
+	ef.Salt = make([]byte, 16)
+	if _, err := rand.Read(ef.Salt); err != nil {
+		return err
+	}
+	aead, err := newAEAD(passphrase, ef.Salt, ef.N, ef.R, ef.P)
+	if err != nil {
+		return err
+	}
+	ef.Nonce = make([]byte, aead.NonceSize())
+	if _, err := rand.Read(ef.Nonce); err != nil {
+		return err
+	}
+	ef.Ciphertext = aead.Seal(nil, ef.Nonce, plaintext, nil)
+
+	data, err := json.MarshalIndent(ef, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(f.Path), 0o700); err != nil {
+		return err
+	}
+	// A fresh temporary file is created with mode 0600, whatever an earlier
+	// failed write may have left behind, and renamed over the old file.
+	tmp, err := os.CreateTemp(filepath.Dir(f.Path), "."+filepath.Base(f.Path)+".*")
+	if err != nil {
+		return err
+	}
+	_, err = tmp.Write(append(data, '\n'))
+	if err == nil {
+		err = tmp.Sync()
+	}
+	err = errors.Join(err, tmp.Close())
+	if err == nil {
+		err = os.Rename(tmp.Name(), f.Path)
+	}
+	if err != nil {
+		_ = os.Remove(tmp.Name())
+	}
+	return err
+}
+
+func (f *File) getPassphrase(create bool) ([]byte, error) {
+	if f.passphrase != nil {
+		return f.passphrase, nil
+	}
+	if f.Passphrase == nil {
+		return nil, errors.New("no passphrase available for the credentials file")
+	}
+	p, err := f.Passphrase(create)
+	if err != nil {
+		return nil, err
+	}
+	if len(p) == 0 {
+		return nil, errors.New("the credentials file passphrase must not be empty")
+	}
+	f.passphrase = p
+	return p, nil
+}
+
+// checkScryptParams rejects scrypt parameters outside the limits above.
+func checkScryptParams(n, r, p int) error {
+	if n < minScryptN || n > maxScryptN || n&(n-1) != 0 {
+		return fmt.Errorf("scrypt N %d must be a power of two between %d and %d", n, minScryptN, maxScryptN)
+	}
+	if r < 1 || r > maxScryptR {
+		return fmt.Errorf("scrypt r %d must be between 1 and %d", r, maxScryptR)
+	}
+	if p < 1 || p > maxScryptP {
+		return fmt.Errorf("scrypt p %d must be between 1 and %d", p, maxScryptP)
+	}
+	if 128*n*r > maxScryptMemory {
+		return fmt.Errorf("scrypt N %d and r %d need more than %d MiB of memory", n, r, maxScryptMemory>>20)
+	}
+	return nil
+}
+
+func newAEAD(passphrase, salt []byte, n, r, p int) (cipher.AEAD, error) {
+	key, err := scrypt.Key(passphrase, salt, n, r, p, 32)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package credentials
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+)
+
+// newFile returns a File in a temporary directory that answers every
+// passphrase prompt with passphrase.
+func newFile(t *testing.T, passphrase string) *File {
+	t.Helper()
+	return fileAt(filepath.Join(t.TempDir(), "credentials.enc"), passphrase)
+}
+
+func fileAt(path, passphrase string) *File {
+	return &File{Path: path, Passphrase: func(bool) ([]byte, error) { return []byte(passphrase), nil }}
+}
+
+func TestFileRoundTrip(t *testing.T) {
+	f := newFile(t, "correct horse")
+	if _, err := f.Get("default"); !errors.Is(err, ErrNotFound) {
+		t.Fatalf("Get before Set error = %v, want ErrNotFound", err)
+	}
+
+	work := Credentials{APIKey: "pk1_work", SecretAPIKey: "sk1_work"}
+	home := Credentials{APIKey: "pk1_home", SecretAPIKey: "sk1_home"}
+	if err := f.Set("work", work); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if err := f.Set("home", home); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+
+	data, err := os.ReadFile(f.Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "pk1_") || strings.Contains(string(data), "sk1_") {
+		t.Errorf("credentials file holds a key in the clear:\n%s", data)
+	}
+	if fi, err := os.Stat(f.Path); err != nil {
+		t.Error(err)
+	} else if fi.Mode().Perm() != 0o600 {
+		t.Errorf("credentials file mode = %v, want 0600", fi.Mode().Perm())
+	}
+	if entries, _ := os.ReadDir(filepath.Dir(f.Path)); len(entries) != 1 {
+		t.Errorf("directory holds %d files after saving, want only the credentials file", len(entries))
+	}
+
+	// A new File reads what the first one wrote.
+	again := fileAt(f.Path, "correct horse")
+	for profile, want := range map[string]Credentials{"work": work, "home": home} {
+		if got, err := again.Get(profile); err != nil || got != want {
+			t.Errorf("Get(%q) = %+v, %v; want %+v", profile, got, err, want)
+		}
+	}
+	if _, err := again.Get("other"); !errors.Is(err, ErrNotFound) {
+		t.Errorf("Get(other) error = %v, want ErrNotFound", err)
+	}
+
+	if err := again.Delete("work"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if got, err := fileAt(f.Path, "correct horse").Get("home"); err != nil || got != home {
+		t.Errorf("Get(home) after deleting work = %+v, %v", got, err)
+	}
+	if err := again.Delete("home"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+	if f.Exists() {
+		t.Error("file still exists after deleting its last profile")
+	}
+}
+
+func TestFileWrongPassphrase(t *testing.T) {
+	f := newFile(t, "correct horse")
+	if err := f.Set("default", Credentials{APIKey: "pk1", SecretAPIKey: "sk1"}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	got, err := fileAt(f.Path, "battery staple").Get("default")
+	if !errors.Is(err, ErrWrongPassphrase) {
+		t.Errorf("Get with the wrong passphrase = %+v, %v; want ErrWrongPassphrase", got, err)
+	}
+}
+
+func TestFileTampered(t *testing.T) {
+	tests := []struct {
+		name    string
+		tamper  func(*encryptedFile)
+		wantErr string
+	}{
+		{"huge N", func(ef *encryptedFile) { ef.N = 1 << 30 }, "scrypt N"},
+		{"N not a power of two", func(ef *encryptedFile) { ef.N = 3 << 12 }, "scrypt N"},
+		{"huge r", func(ef *encryptedFile) { ef.R = 1 << 20 }, "scrypt r"},
+		{"huge p", func(ef *encryptedFile) { ef.P = 1 << 20 }, "scrypt p"},
+		{"too much memory", func(ef *encryptedFile) { ef.N, ef.R = 1<<20, 32 }, "memory"},
+		{"short nonce", func(ef *encryptedFile) { ef.Nonce = ef.Nonce[:4] }, ErrWrongPassphrase.Error()},
+		{"ciphertext", func(ef *encryptedFile) { ef.Ciphertext[0] ^= 1 }, ErrWrongPassphrase.Error()},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := newFile(t, "correct horse")
+			if err := f.Set("default", Credentials{APIKey: "pk1", SecretAPIKey: "sk1"}); err != nil {
+				t.Fatalf("Set: %v", err)
+			}
+			data, err := os.ReadFile(f.Path)
+			if err != nil {
+				t.Fatal(err)
+			}
+			var ef encryptedFile
+			if err := json.Unmarshal(data, &ef); err != nil {
+				t.Fatal(err)
+			}
+			tt.tamper(&ef)
+			if data, err = json.Marshal(ef); err != nil {
+				t.Fatal(err)
+			}
+			if err := os.WriteFile(f.Path, data, 0o600); err != nil {
+				t.Fatal(err)
+			}
+
+			_, err = fileAt(f.Path, "correct horse").Get("default")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Get error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestFileIgnoresStaleTemp(t *testing.T) {
+	f := newFile(t, "correct horse")
+	// A leftover from an old version of steamer, readable by everyone.
+	stale := f.Path + ".tmp"
+	if err := os.WriteFile(stale, nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chmod(stale, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	if err := f.Set("default", Credentials{APIKey: "pk1", SecretAPIKey: "sk1"}); err != nil {
+		t.Fatalf("Set: %v", err)
+	}
+	if fi, err := os.Stat(f.Path); err != nil {
+		t.Error(err)
+	} else if fi.Mode().Perm() != 0o600 {
+		t.Errorf("credentials file mode = %v, want 0600", fi.Mode().Perm())
+	}
+}
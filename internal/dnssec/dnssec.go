@@ -0,0 +1,262 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package dnssec derives DS records from DNSKEY records, computing the key
+// tag (RFC 4034 Appendix B) and digest (RFC 4034 section 5.1.4) the same way
+// dnssec-dsfromkey does.
+package dnssec
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Digest types for DS records (IANA "Delegation Signer Digest Algorithms").
+const (
+	DigestSHA256 uint8 = 2
+	DigestSHA384 uint8 = 4
+)
+
+// Flags of interest in a DNSKEY record.
+const (
+	FlagZoneKey uint16 = 256
+	FlagSEP     uint16 = 1
+)
+
+// algRSAMD5 is the only algorithm whose key tag is computed differently.
+const algRSAMD5 = 1
+
+// DNSKEY is a parsed DNSKEY record.
+type DNSKEY struct {
+	// Owner is the zone the key belongs to, without a trailing dot. It is
+	// empty if the record didn't include one.
+	Owner     string
+	Flags     uint16
+	Protocol  uint8
+	Algorithm uint8
+	PublicKey []byte
+}
+
+// DS is a delegation signer record.
+type DS struct {
+	KeyTag     uint16
+	Algorithm  uint8
+	DigestType uint8
+	Digest     []byte
+}
+
+// String returns the DS record in presentation format, e.g.
+// "2371 13 2 1F98...".
+func (d *DS) String() string {
+	return fmt.Sprintf("%d %d %d %s", d.KeyTag, d.Algorithm, d.DigestType, d.HexDigest())
+}
+
+// HexDigest returns the digest as upper-case hex.
+func (d *DS) HexDigest() string {
+	return strings.ToUpper(hex.EncodeToString(d.Digest))
+}
+
+// ParseDNSKEY parses a DNSKEY record in presentation format. The owner, TTL
+// and class are optional, so both of these work:
+//
+//	example.com. 3600 IN DNSKEY 257 3 13 mdsswUyr3DPW...
+//	257 3 13 mdsswUyr3DPW...
+//
+// Parentheses and ; comments, as found in zone files and BIND key files,
+// are ignored.
+func ParseDNSKEY(s string) (*DNSKEY, error) {
+	fields := strings.Fields(stripComments(s))
+
+	k := &DNSKEY{}
+	if i := indexFold(fields, "DNSKEY"); i >= 0 {
+		if i > 0 {
+			owner := fields[0]
+			if _, err := strconv.Atoi(owner); err != nil && !isClass(owner) {
+				k.Owner = strings.ToLower(strings.TrimSuffix(owner, "."))
+			}
+		}
+		fields = fields[i+1:]
+	}
+	if len(fields) < 4 {
+		return nil, fmt.Errorf(`DNSKEY record must be "flags protocol algorithm public-key", got %d fields`, len(fields))
+	}
+
+	flags, err := strconv.ParseUint(fields[0], 10, 16)
+	if err != nil {
+		return nil, fmt.Errorf("DNSKEY flags %q must be a number between 0 and 65535", fields[0])
+	}
+	protocol, err := strconv.ParseUint(fields[1], 10, 8)
+	if err != nil || protocol != 3 {
+		return nil, fmt.Errorf("DNSKEY protocol %q must be 3", fields[1])
+	}
+	alg, err := strconv.ParseUint(fields[2], 10, 8)
+	if err != nil {
+		return nil, fmt.Errorf("DNSKEY algorithm %q must be a number between 0 and 255", fields[2])
+	}
+	pub, err := base64.StdEncoding.DecodeString(strings.Join(fields[3:], ""))
+	if err != nil || len(pub) == 0 {
+		return nil, fmt.Errorf("DNSKEY public key is not valid base64")
+	}
+
+	k.Flags = uint16(flags)
+	k.Protocol = uint8(protocol)
+	k.Algorithm = uint8(alg)
+	k.PublicKey = pub
+	return k, nil
+}
+
+// ReadKeyFile reads the public key from a BIND K<zone>+<alg>+<tag>.key file.
+func ReadKeyFile(path string) (*DNSKEY, error) {
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	k, err := ParseDNSKEY(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return k, nil
+}
+
+// IsKSK reports whether the key has the secure entry point flag set, which
+// marks the key signing keys that DS records usually point at.
+func (k *DNSKEY) IsKSK() bool {
+	return k.Flags&FlagSEP != 0
+}
+
+// RDATA returns the wire format of the record data.
+func (k *DNSKEY) RDATA() []byte {
+	b := make([]byte, 4, 4+len(k.PublicKey))
+	binary.BigEndian.PutUint16(b, k.Flags)
+	b[2] = k.Protocol
+	b[3] = k.Algorithm
+	return append(b, k.PublicKey...)
+}
+
+// KeyTag computes the key tag as described in RFC 4034 Appendix B.
+func (k *DNSKEY) KeyTag() uint16 {
+	if k.Algorithm == algRSAMD5 {
+		// The tag is the most significant 16 of the least significant 24
+		// bits of the modulus.
+		if n := len(k.PublicKey); n >= 3 {
+			return binary.BigEndian.Uint16(k.PublicKey[n-3 : n-1])
+		}
+		return 0
+	}
+	var ac uint32
+	for i, b := range k.RDATA() {
+		if i&1 == 1 {
+			ac += uint32(b)
+		} else {
+			ac += uint32(b) << 8
+		}
+	}
+	ac += ac >> 16 & 0xFFFF
+	return uint16(ac & 0xFFFF)
+}
+
+// DS computes the DS record for the key. owner is the zone the key belongs
+// to; if empty, k.Owner is used.
+func (k *DNSKEY) DS(owner string, digestType uint8) (*DS, error) {
+	if owner == "" {
+		owner = k.Owner
+	}
+	if owner == "" {
+		return nil, fmt.Errorf("the zone name is needed to compute a DS digest")
+	}
+	if k.Flags&FlagZoneKey == 0 {
+		return nil, fmt.Errorf("DNSKEY flags %d do not have the zone key bit set", k.Flags)
+	}
+	name, err := canonicalName(owner)
+	if err != nil {
+		return nil, err
+	}
+	data := append(name, k.RDATA()...)
+
+	var digest []byte
+	switch digestType {
+	case DigestSHA256:
+		sum := sha256.Sum256(data)
+		digest = sum[:]
+	case DigestSHA384:
+		sum := sha512.Sum384(data)
+		digest = sum[:]
+	default:
+		return nil, fmt.Errorf("unsupported digest type %d (use %d for SHA-256 or %d for SHA-384)", digestType, DigestSHA256, DigestSHA384)
+	}
+	return &DS{
+		KeyTag:     k.KeyTag(),
+		Algorithm:  k.Algorithm,
+		DigestType: digestType,
+		Digest:     digest,
+	}, nil
+}
+
+// canonicalName returns name in canonical wire format: lower case,
+// length-prefixed labels ending with the root label.
+func canonicalName(name string) ([]byte, error) {
+	name = strings.ToLower(strings.TrimSuffix(name, "."))
+	var b []byte
+	if name != "" {
+		for _, label := range strings.Split(name, ".") {
+			if label == "" || len(label) > 63 {
+				return nil, fmt.Errorf("%q is not a valid zone name", name)
+			}
+			b = append(b, byte(len(label)))
+			b = append(b, label...)
+		}
+	}
+	if len(b) > 254 {
+		return nil, fmt.Errorf("%q is not a valid zone name", name)
+	}
+	return append(b, 0), nil
+}
+
+// stripComments removes ; comments and parentheses, joining the lines.
+func stripComments(s string) string {
+	var out strings.Builder
+	sc := bufio.NewScanner(strings.NewReader(s))
+	for sc.Scan() {
+		line, _, _ := strings.Cut(sc.Text(), ";")
+		line = strings.NewReplacer("(", " ", ")", " ").Replace(line)
+		out.WriteString(line)
+		out.WriteByte(' ')
+	}
+	return out.String()
+}
+
+func indexFold(fields []string, s string) int {
+	for i, f := range fields {
+		if strings.EqualFold(f, s) {
+			return i
+		}
+	}
+	return -1
+}
+
+func isClass(s string) bool {
+	switch strings.ToUpper(s) {
+	case "IN", "CH", "HS":
+		return true
+	}
+	return false
+}
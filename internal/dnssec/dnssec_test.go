@@ -0,0 +1,109 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package dnssec
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestDS(t *testing.T) {
+	tests := []struct {
+		name       string
+		dnskey     string
+		digestType uint8
+		want       string
+	}{
+		{
+			// RFC 4509 section 2.3.
+			name: "RFC 4509 SHA-256",
+			dnskey: `dskey.example.com. 86400 IN DNSKEY 256 3 5 ( AQOeiiR0GOMYkDshWoSKz9XzfwJr1AYtsmx3TGkJaNXVbfi/
+				2pHm822aJ5iI9BMzNXxeYCmZDRD99WYwYqUSdjMmmAphXdvx
+				egXd/M5+X7OrzKBaMbCVdFLUUh6DhweJBjEVv5f2wwjM9Xzc
+				nOf+EPbtG9DMBmADjFDc2w/rljwvFw==
+				) ; key id = 60485`,
+			digestType: DigestSHA256,
+			want:       "60485 5 2 D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A",
+		},
+		{
+			// RFC 6605 section 6.1.
+			name:       "RFC 6605 ECDSA P-256",
+			dnskey:     "example.net. 3600 IN DNSKEY 257 3 13 GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA==",
+			digestType: DigestSHA256,
+			want:       "55648 13 2 B4C8C1FE2E7477127B27115656AD6256F424625BF5C1E2770CE6D6E37DF61D17",
+		},
+		{
+			// RFC 6605 section 6.2.
+			name:       "RFC 6605 ECDSA P-384",
+			dnskey:     "example.net. 3600 IN DNSKEY 257 3 14 xKYaNhWdGOfJ+nPrL8/arkwf2EY3MDJ+SErKivBVSum1w/egsXvSADtNJhyem5RCOpgQ6K8X1DRSEkrbYQ+OB+v8/uX45NBwY8rp65F6Glur8I/mlVNgF6W/qTI37m40",
+			digestType: DigestSHA384,
+			want:       "10771 14 4 72D7B62976CE06438E9C0BF319013CF801F09ECC84B8D7E9495F27E305C6A9B0563A9B5F4D288405C3008A946DF983D6",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			k, err := ParseDNSKEY(tt.dnskey)
+			if err != nil {
+				t.Fatalf("ParseDNSKEY: %v", err)
+			}
+			ds, err := k.DS("", tt.digestType)
+			if err != nil {
+				t.Fatalf("DS: %v", err)
+			}
+			if got := ds.String(); got != tt.want {
+				t.Errorf("DS = %s\n          want %s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseDNSKEYWithoutOwner(t *testing.T) {
+	k, err := ParseDNSKEY("257 3 13 GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA==")
+	if err != nil {
+		t.Fatalf("ParseDNSKEY: %v", err)
+	}
+	if k.Owner != "" || !k.IsKSK() || k.KeyTag() != 55648 {
+		t.Errorf("got owner %q, KSK %v, key tag %d; want no owner, KSK, 55648", k.Owner, k.IsKSK(), k.KeyTag())
+	}
+	if _, err := k.DS("", DigestSHA256); err == nil {
+		t.Error("DS without an owner succeeded")
+	}
+	ds, err := k.DS("Example.NET.", DigestSHA256)
+	if err != nil {
+		t.Fatalf("DS: %v", err)
+	}
+	if ds.HexDigest() != "B4C8C1FE2E7477127B27115656AD6256F424625BF5C1E2770CE6D6E37DF61D17" {
+		t.Errorf("digest with an explicit owner = %s", ds.HexDigest())
+	}
+}
+
+func TestReadKeyFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "Kexample.net.+013+55648.key")
+	key := "; This is a key-signing key, keyid 55648, for example.net.\nexample.net. 3600 IN DNSKEY 257 3 13 GojIhhXUN/u4v54ZQqGSnyhWJwaubCvTmeexv7bR6edbkrSqQpF64cYbcB7wNcP+e+MAnLr+Wi9xMWyQLc8NAA==\n"
+	if err := os.WriteFile(path, []byte(key), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	k, err := ReadKeyFile(path)
+	if err != nil {
+		t.Fatalf("ReadKeyFile: %v", err)
+	}
+	if k.KeyTag() != 55648 {
+		t.Errorf("key tag = %d, want 55648", k.KeyTag())
+	}
+	if _, err := ReadKeyFile(path + ".missing"); err == nil {
+		t.Error("ReadKeyFile of a missing file succeeded")
+	}
+}
@@ -0,0 +1,166 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+// Action is the kind of change a plan makes to a single record.
+type Action int
+
+const (
+	// Create adds a record that exists in the zone file but not at Porkbun.
+	Create Action = iota
+	// Update edits a live record in place to match the zone file.
+	Update
+	// Delete removes a live record that the zone file doesn't declare.
+	Delete
+)
+
+func (a Action) String() string {
+	switch a {
+	case Create:
+		return "create"
+	case Update:
+		return "update"
+	case Delete:
+		return "delete"
+	}
+	return "unknown"
+}
+
+// Change is a single step of a plan. Current is nil for creates and Desired
+// is nil for deletes.
+type Change struct {
+	Action  Action
+	Name    string
+	Type    string
+	Current *porkbun.DNSRecord
+	Desired *Record
+}
+
+// key groups records that share a relative name and type.
+type key struct {
+	name string
+	typ  string
+}
+
+// Diff computes the changes needed to make the live records of domain match
+// desired. Within each name and type, records with matching content are kept
+// (and updated if their TTL, priority or notes differ); remaining desired
+// records are paired with remaining live ones as in-place updates, and
+// whatever is left over is created. Live records left unmatched are only
+// deleted when prune is set. Porkbun's own root NS records are never pruned
+// unless the zone declares root NS records itself.
+func Diff(domain string, desired []Entry, current []porkbun.DNSRecord, prune bool) []Change {
+	want := make(map[key][]Record)
+	for _, e := range desired {
+		k := key{e.Name, e.Type}
+		want[k] = append(want[k], e.Record)
+	}
+
+	have := make(map[key][]porkbun.DNSRecord)
+	for _, r := range current {
+		k := key{porkbun.Subdomain(r.Name, domain), strings.ToUpper(r.Type)}
+		have[k] = append(have[k], r)
+	}
+
+	var changes []Change
+	for k, records := range want {
+		live := have[k]
+		used := make([]bool, len(live))
+		var unmatched []Record
+
+		for _, d := range records {
+			found := false
+			for i, l := range live {
+				if used[i] || !porkbun.SameContent(k.typ, d.Content, l.Content) {
+					continue
+				}
+				used[i] = true
+				found = true
+				if !sameSettings(d, l) {
+					changes = append(changes, Change{Action: Update, Name: k.name, Type: k.typ, Current: &live[i], Desired: &d})
+				}
+				break
+			}
+			if !found {
+				unmatched = append(unmatched, d)
+			}
+		}
+
+		for _, d := range unmatched {
+			change := Change{Action: Create, Name: k.name, Type: k.typ, Desired: &d}
+			for i := range live {
+				if !used[i] {
+					used[i] = true
+					change.Action = Update
+					change.Current = &live[i]
+					break
+				}
+			}
+			changes = append(changes, change)
+		}
+
+		if prune {
+			for i := range live {
+				if !used[i] {
+					changes = append(changes, Change{Action: Delete, Name: k.name, Type: k.typ, Current: &live[i]})
+				}
+			}
+		}
+	}
+
+	if prune {
+		for k, live := range have {
+			if _, ok := want[k]; ok {
+				continue
+			}
+			if k.name == "" && k.typ == "NS" {
+				continue
+			}
+			for i := range live {
+				changes = append(changes, Change{Action: Delete, Name: k.name, Type: k.typ, Current: &live[i]})
+			}
+		}
+	}
+
+	sort.SliceStable(changes, func(i, j int) bool {
+		if changes[i].Name != changes[j].Name {
+			return changes[i].Name < changes[j].Name
+		}
+		if changes[i].Type != changes[j].Type {
+			return changes[i].Type < changes[j].Type
+		}
+		return changes[i].Action < changes[j].Action
+	})
+	return changes
+}
+
+// sameSettings reports whether the TTL, priority and notes of a live record
+// already match the desired record. Notes are only compared when set.
+func sameSettings(d Record, l porkbun.DNSRecord) bool {
+	if d.TTL != l.TTL || d.Prio != l.Prio {
+		return false
+	}
+	if d.Notes != nil && *d.Notes != l.Notes {
+		return false
+	}
+	return true
+}
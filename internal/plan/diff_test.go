@@ -0,0 +1,150 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+func entry(name, typ, content string) Entry {
+	return Entry{Name: name, Type: typ, Record: Record{Content: content, TTL: DefaultTTL}}
+}
+
+func live(id, name, typ, content string) porkbun.DNSRecord {
+	return porkbun.DNSRecord{ID: id, Name: name, Type: typ, Content: content, TTL: DefaultTTL}
+}
+
+// describe renders changes as "action name type current -> desired" lines.
+func describe(changes []Change) []string {
+	var lines []string
+	for _, c := range changes {
+		line := fmt.Sprintf("%s %s %s", c.Action, displayName(c.Name), c.Type)
+		if c.Current != nil {
+			line += " " + c.Current.ID
+		}
+		if c.Desired != nil {
+			line += fmt.Sprintf(" -> %s ttl=%d", c.Desired.Content, c.Desired.TTL)
+		}
+		lines = append(lines, line)
+	}
+	return lines
+}
+
+func TestDiff(t *testing.T) {
+	notes := "primary"
+	withTTL := entry("www", "A", "192.0.2.1")
+	withTTL.TTL = 3600
+	withNotes := entry("www", "A", "192.0.2.1")
+	withNotes.Notes = &notes
+	liveNotes := live("1", "www.example.com", "A", "192.0.2.1")
+	liveNotes.Notes = "old"
+
+	tests := []struct {
+		name    string
+		desired []Entry
+		current []porkbun.DNSRecord
+		prune   bool
+		want    []string
+	}{
+		{
+			name:    "in sync",
+			desired: []Entry{entry("", "MX", "mail.example.com"), entry("www", "A", "192.0.2.1")},
+			current: []porkbun.DNSRecord{live("1", "example.com", "MX", "Mail.Example.com."), live("2", "www.example.com", "A", "192.0.2.1")},
+		},
+		{
+			name:    "create",
+			desired: []Entry{entry("www", "A", "192.0.2.1"), entry("www", "AAAA", "2001:db8::1")},
+			current: []porkbun.DNSRecord{live("1", "www.example.com", "A", "192.0.2.1")},
+			want:    []string{"create www AAAA -> 2001:db8::1 ttl=600"},
+		},
+		{
+			name:    "TTL only",
+			desired: []Entry{withTTL},
+			current: []porkbun.DNSRecord{live("1", "www.example.com", "A", "192.0.2.1")},
+			want:    []string{"update www A 1 -> 192.0.2.1 ttl=3600"},
+		},
+		{
+			name:    "notes only when set",
+			desired: []Entry{entry("www", "A", "192.0.2.1")},
+			current: []porkbun.DNSRecord{liveNotes},
+		},
+		{
+			name:    "notes changed",
+			desired: []Entry{withNotes},
+			current: []porkbun.DNSRecord{liveNotes},
+			want:    []string{"update www A 1 -> 192.0.2.1 ttl=600"},
+		},
+		{
+			name:    "matching values kept, others edited in place",
+			desired: []Entry{entry("www", "A", "192.0.2.1"), entry("www", "A", "192.0.2.2")},
+			current: []porkbun.DNSRecord{live("1", "www.example.com", "A", "192.0.2.2"), live("2", "www.example.com", "A", "192.0.2.3")},
+			want:    []string{"update www A 2 -> 192.0.2.1 ttl=600"},
+		},
+		{
+			name:    "more values than live records",
+			desired: []Entry{entry("www", "A", "192.0.2.1"), entry("www", "A", "192.0.2.2"), entry("www", "A", "192.0.2.3")},
+			current: []porkbun.DNSRecord{live("1", "www.example.com", "A", "192.0.2.2")},
+			want:    []string{"create www A -> 192.0.2.1 ttl=600", "create www A -> 192.0.2.3 ttl=600"},
+		},
+		{
+			name:    "fewer values kept without prune",
+			desired: []Entry{entry("www", "A", "192.0.2.1")},
+			current: []porkbun.DNSRecord{live("1", "www.example.com", "A", "192.0.2.1"), live("2", "www.example.com", "A", "192.0.2.2")},
+		},
+		{
+			name:    "fewer values deleted with prune",
+			desired: []Entry{entry("www", "A", "192.0.2.1")},
+			current: []porkbun.DNSRecord{live("1", "www.example.com", "A", "192.0.2.1"), live("2", "www.example.com", "A", "192.0.2.2")},
+			prune:   true,
+			want:    []string{"delete www A 2"},
+		},
+		{
+			name:    "undeclared records kept without prune",
+			desired: []Entry{entry("www", "A", "192.0.2.1")},
+			current: []porkbun.DNSRecord{live("1", "www.example.com", "A", "192.0.2.1"), live("2", "example.com", "TXT", "v=spf1 -all")},
+		},
+		{
+			name:    "undeclared records deleted with prune, except Porkbun's NS",
+			desired: []Entry{entry("www", "A", "192.0.2.1")},
+			current: []porkbun.DNSRecord{
+				live("1", "www.example.com", "A", "192.0.2.1"),
+				live("2", "example.com", "TXT", "v=spf1 -all"),
+				live("3", "example.com", "NS", "curitiba.ns.porkbun.com"),
+			},
+			prune: true,
+			want:  []string{"delete @ TXT 2"},
+		},
+		{
+			name:    "declared root NS are pruned",
+			desired: []Entry{entry("", "NS", "ns1.example.net")},
+			current: []porkbun.DNSRecord{live("1", "example.com", "NS", "curitiba.ns.porkbun.com"), live("2", "example.com", "NS", "fortaleza.ns.porkbun.com")},
+			prune:   true,
+			want:    []string{"update @ NS 1 -> ns1.example.net ttl=600", "delete @ NS 2"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := describe(Diff("example.com", tt.desired, tt.current, tt.prune))
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("Diff =\n\t%s\nwant\n\t%s", strings.Join(got, "\n\t"), strings.Join(tt.want, "\n\t"))
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package plan compares a declarative description of a domain's DNS records
+// with the records live at Porkbun and computes the changes needed to
+// converge them.
+package plan
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+
+	"go.yaml.in/yaml/v3"
+)
+
+// DefaultTTL is the TTL used for records that don't set one.
+const DefaultTTL = porkbun.MinTTL
+
+// Zone is the desired state of a single domain, as read from a zone file:
+//
+//	domain: example.com
+//	records:
+//	  "@":
+//	    MX:
+//	      - content: mail.example.com
+//	        prio: 10
+//	  www:
+//	    A: [192.0.2.1, 192.0.2.2]
+//	  blog:
+//	    CNAME: ghs.google.com
+//
+// Records are keyed by name ("@" for the root) and then by type.
+type Zone struct {
+	Domain  string                       `yaml:"domain"`
+	Records map[string]map[string]Values `yaml:"records"`
+}
+
+// Values holds the records for one name and type. A single record may be
+// written without the surrounding list.
+type Values []Record
+
+// UnmarshalYAML accepts either a sequence of records or a single record.
+func (v *Values) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind != yaml.SequenceNode {
+		var r Record
+		if err := value.Decode(&r); err != nil {
+			return err
+		}
+		*v = Values{r}
+		return nil
+	}
+	return value.Decode((*[]Record)(v))
+}
+
+// Record is a single desired record value. In the zone file it can be given
+// as a bare content string or as a mapping with content, ttl, prio and notes.
+type Record struct {
+	Content string  `yaml:"content"`
+	TTL     int     `yaml:"ttl,omitempty"`
+	Prio    int     `yaml:"prio,omitempty"`
+	Notes   *string `yaml:"notes,omitempty"`
+}
+
+// UnmarshalYAML accepts either a scalar content value or a full mapping.
+func (r *Record) UnmarshalYAML(value *yaml.Node) error {
+	if value.Kind == yaml.ScalarNode {
+		r.Content = value.Value
+		return nil
+	}
+	type plain Record
+	return value.Decode((*plain)(r))
+}
+
+// Entry is a desired record flattened with its name and type. Name is
+// relative to the zone's domain, with "" for the root.
+type Entry struct {
+	Name string
+	Type string
+	Record
+}
+
+// Load reads and validates a zone file.
+func Load(path string) (*Zone, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var z Zone
+	if err := yaml.Unmarshal(data, &z); err != nil {
+		return nil, fmt.Errorf("parsing %s: %w", path, err)
+	}
+	z.Domain = strings.TrimSuffix(strings.ToLower(z.Domain), ".")
+	if z.Domain == "" {
+		return nil, fmt.Errorf("%s: missing 'domain'", path)
+	}
+	for _, e := range z.Entries() {
+		if e.Content == "" {
+			return nil, fmt.Errorf("%s: %s record for %q has no content", path, e.Type, displayName(e.Name))
+		}
+		if err := porkbun.ValidateContent(e.Type, e.Content); err != nil {
+			return nil, fmt.Errorf("%s: %s record for %q: %w", path, e.Type, displayName(e.Name), err)
+		}
+		if e.TTL != 0 {
+			if err := porkbun.ValidateTTL(e.TTL); err != nil {
+				return nil, fmt.Errorf("%s: %s record for %q: %w", path, e.Type, displayName(e.Name), err)
+			}
+		}
+	}
+	return &z, nil
+}
+
+// Entries flattens the zone into a list of records sorted by name and type,
+// with names made relative and types upper-cased.
+func (z *Zone) Entries() []Entry {
+	var entries []Entry
+	for name, types := range z.Records {
+		rel := relativeName(name, z.Domain)
+		for typ, records := range types {
+			for _, r := range records {
+				if r.TTL == 0 {
+					r.TTL = DefaultTTL
+				}
+				entries = append(entries, Entry{Name: rel, Type: strings.ToUpper(typ), Record: r})
+			}
+		}
+	}
+	sort.SliceStable(entries, func(i, j int) bool {
+		if entries[i].Name != entries[j].Name {
+			return entries[i].Name < entries[j].Name
+		}
+		return entries[i].Type < entries[j].Type
+	})
+	return entries
+}
+
+// relativeName maps "@" to the root and otherwise defers to
+// porkbun.Subdomain, so both relative and fully-qualified names work.
+func relativeName(name, domain string) string {
+	if name == "@" {
+		return ""
+	}
+	return porkbun.Subdomain(name, domain)
+}
+
+func displayName(name string) string {
+	if name == "" {
+		return "@"
+	}
+	return name
+}
@@ -0,0 +1,92 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package plan
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+func writeZone(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "zone.yaml")
+	if err := os.WriteFile(path, []byte(content), 0644); err != nil {
+		t.Fatal(err)
+	}
+	return path
+}
+
+func TestLoad(t *testing.T) {
+	z, err := Load(writeZone(t, `domain: Example.COM.
+records:
+  "@":
+    mx:
+      - content: mail.example.com
+        prio: 10
+        ttl: 3600
+  www.example.com:
+    A: [192.0.2.1, 192.0.2.2]
+  blog:
+    CNAME: ghs.google.com
+`))
+	if err != nil {
+		t.Fatalf("Load: %v", err)
+	}
+	if z.Domain != "example.com" {
+		t.Errorf("Domain = %q, want example.com", z.Domain)
+	}
+	want := []Entry{
+		{Name: "", Type: "MX", Record: Record{Content: "mail.example.com", TTL: 3600, Prio: 10}},
+		{Name: "blog", Type: "CNAME", Record: Record{Content: "ghs.google.com", TTL: DefaultTTL}},
+		{Name: "www", Type: "A", Record: Record{Content: "192.0.2.1", TTL: DefaultTTL}},
+		{Name: "www", Type: "A", Record: Record{Content: "192.0.2.2", TTL: DefaultTTL}},
+	}
+	if got := z.Entries(); !reflect.DeepEqual(got, want) {
+		t.Errorf("Entries =\n%+v\nwant\n%+v", got, want)
+	}
+}
+
+func TestLoadInvalid(t *testing.T) {
+	tests := []struct {
+		name    string
+		zone    string
+		wantErr string
+		invalid bool // the error matches porkbun.ErrInvalidRequest
+	}{
+		{"no domain", "records:\n  www:\n    A: 192.0.2.1\n", "missing 'domain'", false},
+		{"no content", "domain: example.com\nrecords:\n  www:\n    A: {ttl: 600}\n", "has no content", false},
+		{"low TTL", "domain: example.com\nrecords:\n  www:\n    A: {content: 192.0.2.1, ttl: 300}\n", "below the minimum", true},
+		{"bad address", "domain: example.com\nrecords:\n  www:\n    A: [192.0.2.1, not-an-ip]\n", "not an IP address", true},
+		{"IP as CNAME", "domain: example.com\nrecords:\n  blog:\n    CNAME: 192.0.2.1\n", "must be a hostname", true},
+		{"unknown type", "domain: example.com\nrecords:\n  www:\n    PTR: host.example.com\n", "unsupported record type", true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Load(writeZone(t, tt.zone))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Load error = %v, want one containing %q", err, tt.wantErr)
+			}
+			if tt.invalid && !errors.Is(err, porkbun.ErrInvalidRequest) {
+				t.Errorf("Load error %v does not match ErrInvalidRequest", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,165 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// DefaultCacheTTLs are how long responses from each read-only endpoint are
+// served from the cache, keyed by the endpoint without its domain or other
+// arguments. Endpoints that aren't listed, such as ping, availability checks
+// and SSL certificates, are never cached.
+var DefaultCacheTTLs = map[string]time.Duration{
+	"domain/listAll":          time.Hour,
+	"dns/retrieve":            10 * time.Minute,
+	"dns/retrieveByNameType":  10 * time.Minute,
+	"dns/getDnssecRecords":    time.Hour,
+	"domain/getNs":            time.Hour,
+	"domain/getUrlForwarding": time.Hour,
+	"domain/getGlue":          time.Hour,
+	"pricing/get":             7 * 24 * time.Hour,
+}
+
+// Cache keeps API responses on disk so repeated reads don't go back to
+// Porkbun. Responses are stored per domain, and a successful request that
+// changes a domain drops everything cached for it.
+type Cache struct {
+	// Dir is where responses are stored. An empty Dir caches nothing.
+	Dir string
+	// TTLs maps endpoints to how long their responses stay fresh, as in
+	// DefaultCacheTTLs.
+	TTLs map[string]time.Duration
+	// Offline serves cached responses however old they are and fails
+	// every other request with ErrOffline instead of contacting Porkbun.
+	Offline bool
+	// Refresh ignores cached responses, fetching and storing new ones.
+	Refresh bool
+}
+
+// NewCache returns a cache in dir using DefaultCacheTTLs.
+func NewCache(dir string) *Cache {
+	return &Cache{Dir: dir, TTLs: DefaultCacheTTLs}
+}
+
+// WithCache makes the client read and write responses through cache.
+func WithCache(cache *Cache) Option {
+	return func(c *Client) {
+		c.Cache = cache
+	}
+}
+
+// cacheEntry is the on-disk form of a cached response.
+type cacheEntry struct {
+	Endpoint string          `json:"endpoint"`
+	Fetched  time.Time       `json:"fetched"`
+	Body     json.RawMessage `json:"body"`
+}
+
+// ttl returns how long responses from endpoint stay fresh, or 0 if they
+// aren't cached.
+func (c *Cache) ttl(endpoint string) time.Duration {
+	parts := strings.SplitN(endpoint, "/", 3)
+	if len(parts) < 2 {
+		return 0
+	}
+	return c.TTLs[parts[0]+"/"+parts[1]]
+}
+
+// scope returns the directory holding endpoint's responses: the domain it
+// is about, or the endpoint itself for account-wide calls like pricing/get.
+func (c *Cache) scope(endpoint string) string {
+	parts := strings.SplitN(endpoint, "/", 4)
+	if len(parts) >= 3 {
+		if d := strings.ToLower(parts[2]); d != "" && d != "." && d != ".." && !strings.Contains(d, `\`) {
+			return d
+		}
+	}
+	return strings.ReplaceAll(endpoint, "/", "-")
+}
+
+// path returns the file for a request. The key covers the API endpoint and
+// the request body, which carries the credentials and any parameters, so
+// accounts and pages of results are kept apart without storing the keys.
+func (c *Cache) path(url, endpoint string, body []byte) string {
+	h := sha256.New()
+	h.Write([]byte(url))
+	h.Write([]byte{0})
+	h.Write(body)
+	return filepath.Join(c.Dir, c.scope(endpoint), hex.EncodeToString(h.Sum(nil)[:16])+".json")
+}
+
+// load returns the cached response at path if it may be used.
+func (c *Cache) load(path string, ttl time.Duration) ([]byte, bool) {
+	if c.Dir == "" || (c.Refresh && !c.Offline) {
+		return nil, false
+	}
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, false
+	}
+	var e cacheEntry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return nil, false
+	}
+	if !c.Offline && time.Since(e.Fetched) >= ttl {
+		return nil, false
+	}
+	return e.Body, true
+}
+
+// store saves a response. Failing to cache isn't an error for the request,
+// so problems writing are ignored.
+func (c *Cache) store(path, endpoint string, body []byte) {
+	if c.Dir == "" {
+		return
+	}
+	data, err := json.Marshal(cacheEntry{Endpoint: endpoint, Fetched: time.Now(), Body: body})
+	if err != nil {
+		return
+	}
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return
+	}
+	// Write and rename so concurrent readers never see a partial file.
+	f, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return
+	}
+	_, err = f.Write(data)
+	err = errors.Join(err, f.Close())
+	if err == nil {
+		err = os.Rename(f.Name(), path)
+	}
+	if err != nil {
+		_ = os.Remove(f.Name())
+	}
+}
+
+// invalidate drops every cached response for the domain a mutating
+// endpoint changed.
+func (c *Cache) invalidate(endpoint string) {
+	if c.Dir == "" {
+		return
+	}
+	_ = os.RemoveAll(filepath.Join(c.Dir, c.scope(endpoint)))
+}
@@ -0,0 +1,180 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/porkbun/porkbuntest"
+)
+
+// newCachedServer is like newServer with the client's responses cached in a
+// temporary directory.
+func newCachedServer(t *testing.T, ttls map[string]time.Duration) (*porkbuntest.Server, *porkbun.Client, *porkbun.Cache) {
+	t.Helper()
+	srv, client := newServer(t)
+	cache := porkbun.NewCache(t.TempDir())
+	if ttls != nil {
+		cache.TTLs = ttls
+	}
+	client.Cache = cache
+	return srv, client, cache
+}
+
+// countRequests runs fn and returns how many requests reached srv.
+func countRequests(t *testing.T, srv *porkbuntest.Server, fn func() error) int {
+	t.Helper()
+	before := srv.Requests()
+	if err := fn(); err != nil {
+		t.Fatal(err)
+	}
+	return srv.Requests() - before
+}
+
+func TestCacheTTL(t *testing.T) {
+	srv, client, _ := newCachedServer(t, map[string]time.Duration{
+		"dns/retrieve":   50 * time.Millisecond,
+		"domain/listAll": time.Hour,
+	})
+	ctx := context.Background()
+	records := func() error { _, err := client.RetrieveRecordsContext(ctx, "example.com"); return err }
+	domains := func() error { _, err := client.ListDomainsContext(ctx); return err }
+
+	if n := countRequests(t, srv, records) + countRequests(t, srv, domains); n != 2 {
+		t.Fatalf("first reads sent %d requests, want 2", n)
+	}
+	if n := countRequests(t, srv, records) + countRequests(t, srv, domains); n != 0 {
+		t.Errorf("fresh reads sent %d requests, want 0", n)
+	}
+	time.Sleep(60 * time.Millisecond)
+	if n := countRequests(t, srv, records); n != 1 {
+		t.Errorf("expired records sent %d requests, want 1", n)
+	}
+	if n := countRequests(t, srv, domains); n != 0 {
+		t.Errorf("domains within their TTL sent %d requests, want 0", n)
+	}
+	if n := countRequests(t, srv, func() error { _, err := client.PingContext(ctx); return err }) +
+		countRequests(t, srv, func() error { _, err := client.PingContext(ctx); return err }); n != 2 {
+		t.Errorf("two pings sent %d requests, want 2: ping is never cached", n)
+	}
+}
+
+func TestCacheOffline(t *testing.T) {
+	srv, client, cache := newCachedServer(t, map[string]time.Duration{"dns/retrieve": time.Millisecond})
+	ctx := context.Background()
+	srv.AddRecord("example.com", porkbun.DNSRecord{Name: "www", Type: "A", Content: "192.0.2.1"})
+	if _, err := client.RetrieveRecordsContext(ctx, "example.com"); err != nil {
+		t.Fatalf("RetrieveRecords: %v", err)
+	}
+	time.Sleep(5 * time.Millisecond)
+
+	cache.Offline = true
+	before := srv.Requests()
+	records, err := client.RetrieveRecordsContext(ctx, "example.com")
+	if err != nil || len(records) != 1 {
+		t.Fatalf("offline RetrieveRecords = %+v, %v; want the stale record", records, err)
+	}
+	if _, err := client.GetNameserversContext(ctx, "example.com"); !errors.Is(err, porkbun.ErrOffline) {
+		t.Errorf("offline GetNameservers error = %v, want ErrOffline", err)
+	}
+	if _, err := client.CreateRecordContext(ctx, "example.com", porkbun.CreateRecordRequest{Type: "A", Content: "192.0.2.2"}); !errors.Is(err, porkbun.ErrOffline) {
+		t.Errorf("offline CreateRecord error = %v, want ErrOffline", err)
+	}
+	if n := srv.Requests() - before; n != 0 {
+		t.Errorf("offline client sent %d requests, want 0", n)
+	}
+}
+
+func TestCacheRefresh(t *testing.T) {
+	srv, client, cache := newCachedServer(t, nil)
+	ctx := context.Background()
+	if _, err := client.RetrieveRecordsContext(ctx, "example.com"); err != nil {
+		t.Fatalf("RetrieveRecords: %v", err)
+	}
+	srv.AddRecord("example.com", porkbun.DNSRecord{Name: "www", Type: "A", Content: "192.0.2.1"})
+
+	cache.Refresh = true
+	before := srv.Requests()
+	records, err := client.RetrieveRecordsContext(ctx, "example.com")
+	if err != nil || len(records) != 1 || srv.Requests() != before+1 {
+		t.Fatalf("refreshed RetrieveRecords = %+v, %v after %d requests; want the new record from 1 request", records, err, srv.Requests()-before)
+	}
+
+	// The refreshed response replaces what was cached.
+	cache.Refresh = false
+	records, err = client.RetrieveRecordsContext(ctx, "example.com")
+	if err != nil || len(records) != 1 || srv.Requests() != before+1 {
+		t.Errorf("cached RetrieveRecords = %+v, %v; want the refreshed response without a request", records, err)
+	}
+}
+
+func TestCacheInvalidate(t *testing.T) {
+	srv, client, _ := newCachedServer(t, nil)
+	srv.AddDomain(porkbun.Domain{Domain: "example.net"})
+	ctx := context.Background()
+	read := func(domain string) func() error {
+		return func() error { _, err := client.RetrieveRecordsContext(ctx, domain); return err }
+	}
+	countRequests(t, srv, read("example.com"))
+	countRequests(t, srv, read("example.net"))
+
+	if _, err := client.CreateRecordContext(ctx, "example.com", porkbun.CreateRecordRequest{Type: "A", Content: "192.0.2.1"}); err != nil {
+		t.Fatalf("CreateRecord: %v", err)
+	}
+	if n := countRequests(t, srv, read("example.com")); n != 1 {
+		t.Errorf("records after a write sent %d requests, want 1", n)
+	}
+	if n := countRequests(t, srv, read("example.net")); n != 0 {
+		t.Errorf("another domain's records sent %d requests after the write, want 0", n)
+	}
+
+	// A failed write changed nothing, so the cache is kept.
+	if _, err := client.CreateRecordContext(ctx, "example.com", porkbun.CreateRecordRequest{Type: "A", Content: "bad"}); err == nil {
+		t.Fatal("CreateRecord with bad content succeeded")
+	}
+	if n := countRequests(t, srv, read("example.com")); n != 0 {
+		t.Errorf("records after a failed write sent %d requests, want 0", n)
+	}
+}
+
+func TestCacheSkipsSSL(t *testing.T) {
+	srv, client, cache := newCachedServer(t, nil)
+	srv.SetSSLBundle("example.com", porkbun.SSLBundle{CertificateChain: "chain", PrivateKey: "key", PublicKey: "pub"})
+	ctx := context.Background()
+
+	for range 2 {
+		if _, err := client.RetrieveSSLBundleContext(ctx, "example.com"); err != nil {
+			t.Fatalf("RetrieveSSLBundle: %v", err)
+		}
+	}
+	if n := srv.Requests(); n != 2 {
+		t.Errorf("two SSL retrievals sent %d requests, want 2", n)
+	}
+	err := filepath.WalkDir(cache.Dir, func(path string, d fs.DirEntry, err error) error {
+		if err == nil && !d.IsDir() {
+			t.Errorf("cache holds %s after retrieving an SSL bundle; private keys must not be written to disk", path)
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+}
@@ -17,19 +17,37 @@ package porkbun
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
+	"strings"
+	"time"
 )
 
-const baseURL = "https://api.porkbun.com/api/json/v3"
+// DefaultBaseURL is the Porkbun API v3 endpoint used unless WithBaseURL
+// overrides it.
+const DefaultBaseURL = "https://api.porkbun.com/api/json/v3"
+
+// DefaultTimeout bounds how long a single API request may take, including
+// reading the response body, for clients created with NewClient.
+const DefaultTimeout = 30 * time.Second
 
 // Client is a Porkbun API client.
 type Client struct {
 	APIKey       string
 	SecretAPIKey string
 	HTTPClient   *http.Client
+	// BaseURL is the API endpoint requests are sent to. An empty value
+	// means DefaultBaseURL.
+	BaseURL string
+	// Retry controls how failed requests are retried. The zero value
+	// disables retries.
+	Retry RetryPolicy
+	// Cache, if set, serves read-only requests from disk and is kept up to
+	// date by the client's own changes.
+	Cache *Cache
 }
 
 // BaseRequest contains the credentials required for every Porkbun API request.
@@ -44,53 +62,139 @@ type APIResponse struct {
 	Message string `json:"message,omitempty"`
 }
 
+// Option configures a Client created with NewClient.
+type Option func(*Client)
+
+// WithBaseURL points the client at a different API endpoint, such as a
+// porkbuntest fake server or a proxy.
+func WithBaseURL(url string) Option {
+	return func(c *Client) {
+		c.BaseURL = strings.TrimSuffix(url, "/")
+	}
+}
+
+// WithHTTPClient replaces the HTTP client used for requests.
+func WithHTTPClient(hc *http.Client) Option {
+	return func(c *Client) {
+		c.HTTPClient = hc
+	}
+}
+
 // NewClient creates a new Porkbun API client.
-func NewClient(apiKey, secretKey string) *Client {
-	return &Client{
+func NewClient(apiKey, secretKey string, opts ...Option) *Client {
+	c := &Client{
 		APIKey:       apiKey,
 		SecretAPIKey: secretKey,
-		HTTPClient:   &http.Client{},
+		HTTPClient:   &http.Client{Timeout: DefaultTimeout},
+		BaseURL:      DefaultBaseURL,
+		Retry:        DefaultRetryPolicy,
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
-func (c *Client) post(endpoint string, body interface{}, result interface{}) error {
-	url := fmt.Sprintf("%s/%s", baseURL, endpoint)
-
-	// Inject credentials into the body if it's a map or a struct that embeds BaseRequest
-	// For simplicity in this implementation, we'll assume the caller passes a struct
-	// or we can use a more generic approach.
+func (c *Client) post(ctx context.Context, endpoint string, body interface{}, result interface{}) error {
+	base := c.BaseURL
+	if base == "" {
+		base = DefaultBaseURL
+	}
+	url := fmt.Sprintf("%s/%s", base, endpoint)
 
 	jsonBody, err := json.Marshal(body)
 	if err != nil {
 		return err
 	}
 
-	req, err := http.NewRequest("POST", url, bytes.NewBuffer(jsonBody))
-	if err != nil {
+	if c.Cache == nil {
+		_, err := c.retry(ctx, endpoint, url, jsonBody, result)
 		return err
 	}
+
+	path := c.Cache.path(url, endpoint, jsonBody)
+	ttl := c.Cache.ttl(endpoint)
+	if ttl > 0 {
+		if data, ok := c.Cache.load(path, ttl); ok {
+			return json.Unmarshal(data, result)
+		}
+	}
+	if c.Cache.Offline {
+		return fmt.Errorf("%s: %w", endpoint, ErrOffline)
+	}
+	data, err := c.retry(ctx, endpoint, url, jsonBody, result)
+	switch {
+	case err != nil:
+	case ttl > 0:
+		c.Cache.store(path, endpoint, data)
+	case !isReadOnly(endpoint):
+		c.Cache.invalidate(endpoint)
+	}
+	return err
+}
+
+// retry sends a request, retrying as the client's RetryPolicy allows, and
+// returns the body of the successful response.
+func (c *Client) retry(ctx context.Context, endpoint, url string, jsonBody []byte, result interface{}) ([]byte, error) {
+	for attempt := 0; ; attempt++ {
+		data, err := c.send(ctx, endpoint, url, jsonBody, result)
+		if err == nil {
+			return data, nil
+		}
+		wait, ok := c.Retry.delay(endpoint, err, attempt)
+		if !ok || ctx.Err() != nil {
+			return nil, err
+		}
+		select {
+		case <-time.After(wait):
+		case <-ctx.Done():
+			return nil, err
+		}
+	}
+}
+
+// send performs a single request, decodes the response into result and
+// returns the raw body. Failures reported by Porkbun are returned as
+// *APIError.
+func (c *Client) send(ctx context.Context, endpoint, url string, jsonBody []byte, result interface{}) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, "POST", url, bytes.NewReader(jsonBody))
+	if err != nil {
+		return nil, err
+	}
 	req.Header.Set("Content-Type", "application/json")
 
 	resp, err := c.HTTPClient.Do(req)
 	if err != nil {
-		return err
+		return nil, err
 	}
 	defer func() { _ = resp.Body.Close() }()
 
 	respBody, err := io.ReadAll(resp.Body)
 	if err != nil {
-		return err
+		return nil, err
 	}
 
-	if resp.StatusCode != http.StatusOK {
-		var apiErr APIResponse
-		if err := json.Unmarshal(respBody, &apiErr); err == nil && apiErr.Message != "" {
-			return fmt.Errorf("api error: %s", apiErr.Message)
+	var apiRes APIResponse
+	decodeErr := json.Unmarshal(respBody, &apiRes)
+
+	if resp.StatusCode != http.StatusOK || (decodeErr == nil && apiRes.Status != "" && apiRes.Status != "SUCCESS") {
+		e := &APIError{
+			StatusCode: resp.StatusCode,
+			Status:     apiRes.Status,
+			Message:    apiRes.Message,
+			Endpoint:   endpoint,
+			retryAfter: parseRetryAfter(resp.Header.Get("Retry-After")),
+		}
+		if e.Message == "" {
+			e.Message = strings.TrimSpace(string(respBody))
 		}
-		return fmt.Errorf("api error: %s (status %d)", string(respBody), resp.StatusCode)
+		if e.Message == "" {
+			e.Message = "unknown error"
+		}
+		return nil, e
 	}
 
-	return json.Unmarshal(respBody, result)
+	return respBody, json.Unmarshal(respBody, result)
 }
 
 // PingResponse is the response from the ping endpoint.
@@ -101,17 +205,19 @@ type PingResponse struct {
 
 // Ping checks the connection to the Porkbun API.
 func (c *Client) Ping() (*PingResponse, error) {
+	return c.PingContext(context.Background())
+}
+
+// PingContext is like Ping but uses ctx for the request.
+func (c *Client) PingContext(ctx context.Context) (*PingResponse, error) {
 	req := BaseRequest{
 		APIKey:       c.APIKey,
 		SecretAPIKey: c.SecretAPIKey,
 	}
 	var res PingResponse
-	err := c.post("ping", req, &res)
+	err := c.post(ctx, "ping", req, &res)
 	if err != nil {
 		return nil, err
 	}
-	if res.Status != "SUCCESS" {
-		return nil, fmt.Errorf("ping failed: %s", res.Message)
-	}
 	return &res, nil
 }
@@ -0,0 +1,205 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/porkbun/porkbuntest"
+)
+
+// newServer starts a fake with example.com in the account.
+func newServer(t *testing.T) (*porkbuntest.Server, *porkbun.Client) {
+	t.Helper()
+	srv := porkbuntest.NewServer()
+	t.Cleanup(srv.Close)
+	srv.AddDomain(porkbun.Domain{Domain: "example.com"})
+	client := srv.Client()
+	client.Retry = porkbun.RetryPolicy{}
+	return srv, client
+}
+
+func TestPing(t *testing.T) {
+	srv, client := newServer(t)
+	srv.ClientIP = "192.0.2.7"
+
+	res, err := client.PingContext(context.Background())
+	if err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if res.Status != "SUCCESS" || res.YourIP != "192.0.2.7" {
+		t.Errorf("Ping = %+v, want SUCCESS from 192.0.2.7", res)
+	}
+}
+
+func TestRecordLifecycle(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+
+	id, err := client.CreateRecordContext(ctx, "example.com", porkbun.CreateRecordRequest{
+		Name:    "www",
+		Type:    "A",
+		Content: "192.0.2.1",
+		TTL:     "3600",
+		Notes:   "web",
+	})
+	if err != nil {
+		t.Fatalf("CreateRecord: %v", err)
+	}
+	if id == "" {
+		t.Fatal("CreateRecord returned an empty ID")
+	}
+
+	rec, err := client.RetrieveRecordContext(ctx, "example.com", id)
+	if err != nil {
+		t.Fatalf("RetrieveRecord: %v", err)
+	}
+	want := porkbun.DNSRecord{ID: id, Name: "www.example.com", Type: "A", Content: "192.0.2.1", TTL: 3600, Notes: "web"}
+	if *rec != want {
+		t.Errorf("RetrieveRecord = %+v, want %+v", *rec, want)
+	}
+
+	err = client.EditRecordContext(ctx, "example.com", id, porkbun.EditRecordRequest{
+		Name:    "www",
+		Type:    "A",
+		Content: "192.0.2.2",
+	})
+	if err != nil {
+		t.Fatalf("EditRecord: %v", err)
+	}
+	records, err := client.RetrieveRecordsContext(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("RetrieveRecords: %v", err)
+	}
+	if len(records) != 1 || records[0].ID != id || records[0].Content != "192.0.2.2" {
+		t.Fatalf("RetrieveRecords after edit = %+v, want one record with content 192.0.2.2", records)
+	}
+	if records[0].Notes != "web" {
+		t.Errorf("edit without notes changed them to %q", records[0].Notes)
+	}
+
+	if err := client.DeleteRecordContext(ctx, "example.com", id); err != nil {
+		t.Fatalf("DeleteRecord: %v", err)
+	}
+	if got := srv.Records("example.com"); len(got) != 0 {
+		t.Errorf("records after delete = %+v, want none", got)
+	}
+	if _, err := client.RetrieveRecordContext(ctx, "example.com", id); !errors.Is(err, porkbun.ErrNotFound) {
+		t.Errorf("RetrieveRecord after delete: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestErrorMapping(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+
+	wrongKeys := porkbun.NewClient("pk1_wrong", "sk1_wrong", porkbun.WithBaseURL(srv.URL))
+	wrongKeys.Retry = porkbun.RetryPolicy{}
+
+	tests := []struct {
+		name string
+		call func() error
+		want error
+	}{
+		{"wrong keys", func() error {
+			_, err := wrongKeys.PingContext(ctx)
+			return err
+		}, porkbun.ErrUnauthorized},
+		{"unknown domain", func() error {
+			_, err := client.RetrieveRecordsContext(ctx, "missing.example")
+			return err
+		}, porkbun.ErrNotFound},
+		{"unknown record", func() error {
+			return client.DeleteRecordContext(ctx, "example.com", "1")
+		}, porkbun.ErrNotFound},
+		{"invalid content", func() error {
+			_, err := client.CreateRecordContext(ctx, "example.com", porkbun.CreateRecordRequest{Type: "A", Content: "not-an-ip"})
+			return err
+		}, porkbun.ErrInvalidRequest},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.call()
+			if !errors.Is(err, tt.want) {
+				t.Fatalf("err = %v, want %v", err, tt.want)
+			}
+			var apiErr *porkbun.APIError
+			if !errors.As(err, &apiErr) {
+				t.Errorf("err = %T, want *porkbun.APIError", err)
+			}
+		})
+	}
+}
+
+func TestErrorMappingStatus(t *testing.T) {
+	tests := []struct {
+		code int
+		body string
+		want error
+	}{
+		{http.StatusTooManyRequests, `{"status":"ERROR","message":"Slow down."}`, porkbun.ErrRateLimited},
+		{http.StatusOK, `{"status":"ERROR","message":"You have exceeded the rate limit."}`, porkbun.ErrRateLimited},
+		{http.StatusUnauthorized, `{"status":"ERROR","message":"Nope."}`, porkbun.ErrUnauthorized},
+		{http.StatusNotFound, `not json`, porkbun.ErrNotFound},
+	}
+	for _, tt := range tests {
+		t.Run(fmt.Sprint(tt.code, " ", tt.body), func(t *testing.T) {
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				w.WriteHeader(tt.code)
+				_, _ = w.Write([]byte(tt.body))
+			}))
+			defer srv.Close()
+			client := porkbun.NewClient("pk1", "sk1", porkbun.WithBaseURL(srv.URL))
+			client.Retry = porkbun.RetryPolicy{}
+
+			_, err := client.PingContext(context.Background())
+			if !errors.Is(err, tt.want) {
+				t.Errorf("err = %v, want %v", err, tt.want)
+			}
+		})
+	}
+}
+
+func TestListDomainsPaging(t *testing.T) {
+	srv, client := newServer(t)
+	// The fake returns 1000 domains per page, like Porkbun.
+	for i := range 1500 {
+		srv.AddDomain(porkbun.Domain{Domain: fmt.Sprintf("d%04d.example", i)})
+	}
+
+	domains, err := client.ListDomainsContext(context.Background())
+	if err != nil {
+		t.Fatalf("ListDomains: %v", err)
+	}
+	if len(domains) != 1501 {
+		t.Fatalf("ListDomains returned %d domains, want 1501", len(domains))
+	}
+	seen := make(map[string]bool)
+	for _, d := range domains {
+		if seen[d.Domain] {
+			t.Fatalf("domain %s listed twice", d.Domain)
+		}
+		seen[d.Domain] = true
+	}
+	if domains[0].Domain != "example.com" || domains[1500].Domain != "d1499.example" {
+		t.Errorf("domains not in account order: first %s, last %s", domains[0].Domain, domains[1500].Domain)
+	}
+}
@@ -0,0 +1,111 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// The Porkbun API is loose about JSON types: IDs arrive as numbers or
+// strings, TTLs and priorities as strings, flags as "1"/"0" or "yes"/"no"
+// and dates as "2006-01-02 15:04:05". The decoders below accept all of
+// these, as well as the plain types the models marshal to, so values
+// written by steamer's --json output can be read back.
+
+// porkbunTimeLayout is the layout Porkbun uses for dates.
+const porkbunTimeLayout = "2006-01-02 15:04:05"
+
+// decodeString decodes a JSON string or number as a string. null decodes as
+// "".
+func decodeString(raw json.RawMessage) (string, error) {
+	raw = bytes.TrimSpace(raw)
+	if len(raw) == 0 || bytes.Equal(raw, []byte("null")) {
+		return "", nil
+	}
+	if raw[0] == '"' {
+		var s string
+		err := json.Unmarshal(raw, &s)
+		return s, err
+	}
+	var n json.Number
+	if err := json.Unmarshal(raw, &n); err != nil {
+		return "", err
+	}
+	return n.String(), nil
+}
+
+// decodeInt decodes a JSON number or numeric string as an int. null and ""
+// decode as 0.
+func decodeInt(raw json.RawMessage, field string) (int, error) {
+	s, err := decodeString(raw)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %w", field, err)
+	}
+	if s == "" {
+		return 0, nil
+	}
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return 0, fmt.Errorf("%s: %q is not an integer", field, s)
+	}
+	return n, nil
+}
+
+// decodeBool decodes a JSON boolean, or one of "1"/"0", "yes"/"no" and
+// "true"/"false" as a string or number. null and "" decode as false.
+func decodeBool(raw json.RawMessage, field string) (bool, error) {
+	raw = bytes.TrimSpace(raw)
+	switch string(raw) {
+	case "true":
+		return true, nil
+	case "false":
+		return false, nil
+	}
+	s, err := decodeString(raw)
+	if err != nil {
+		return false, fmt.Errorf("%s: %w", field, err)
+	}
+	switch strings.ToLower(s) {
+	case "1", "yes", "true":
+		return true, nil
+	case "", "0", "no", "false":
+		return false, nil
+	}
+	return false, fmt.Errorf("%s: %q is not a yes/no value", field, s)
+}
+
+// decodeTime decodes a date in Porkbun's layout, which is taken to be UTC,
+// or in RFC 3339. null, "" and Porkbun's all-zero date decode as the zero
+// time.
+func decodeTime(raw json.RawMessage, field string) (time.Time, error) {
+	s, err := decodeString(raw)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("%s: %w", field, err)
+	}
+	if s == "" || strings.HasPrefix(s, "0000-00-00") {
+		return time.Time{}, nil
+	}
+	for _, layout := range []string{porkbunTimeLayout, time.RFC3339, time.DateOnly} {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("%s: %q is not a date", field, s)
+}
@@ -0,0 +1,105 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+func TestDNSRecordUnmarshal(t *testing.T) {
+	want := porkbun.DNSRecord{ID: "106926652", Name: "example.com", Type: "MX", Content: "mail.example.com", TTL: 600, Prio: 10}
+	tests := []struct {
+		name string
+		json string
+	}{
+		{"porkbun strings", `{"id":"106926652","name":"example.com","type":"MX","content":"mail.example.com","ttl":"600","prio":"10","notes":""}`},
+		{"numeric id", `{"id":106926652,"name":"example.com","type":"MX","content":"mail.example.com","ttl":"600","prio":"10","notes":null}`},
+		{"typed", `{"id":"106926652","name":"example.com","type":"MX","content":"mail.example.com","ttl":600,"prio":10,"notes":""}`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got porkbun.DNSRecord
+			if err := json.Unmarshal([]byte(tt.json), &got); err != nil {
+				t.Fatalf("Unmarshal: %v", err)
+			}
+			if got != want {
+				t.Errorf("got %+v, want %+v", got, want)
+			}
+		})
+	}
+
+	var r porkbun.DNSRecord
+	if err := json.Unmarshal([]byte(`{"ttl":"soon"}`), &r); err == nil {
+		t.Error("Unmarshal accepted a non-numeric ttl")
+	}
+}
+
+func TestDomainUnmarshal(t *testing.T) {
+	wire := `{"domain":"example.com","status":"ACTIVE","tld":"com","createDate":"2018-08-20 17:52:51","expireDate":"0000-00-00 00:00:00",
+		"securityLock":"1","whoisPrivacy":"0","autoRenew":1,"notLocal":0,"labels":[]}`
+	var d porkbun.Domain
+	if err := json.Unmarshal([]byte(wire), &d); err != nil {
+		t.Fatalf("Unmarshal: %v", err)
+	}
+	want := porkbun.Domain{
+		Domain:       "example.com",
+		Status:       "ACTIVE",
+		TLD:          "com",
+		CreateDate:   time.Date(2018, 8, 20, 17, 52, 51, 0, time.UTC),
+		SecurityLock: true,
+		AutoRenew:    true,
+		Labels:       []porkbun.Label{},
+	}
+	if !reflect.DeepEqual(d, want) {
+		t.Errorf("got %+v, want %+v", d, want)
+	}
+
+	// What steamer's --json output writes must read back the same.
+	b, err := json.Marshal(d)
+	if err != nil {
+		t.Fatalf("Marshal: %v", err)
+	}
+	var again porkbun.Domain
+	if err := json.Unmarshal(b, &again); err != nil {
+		t.Fatalf("Unmarshal of %s: %v", b, err)
+	}
+	if !reflect.DeepEqual(again, d) {
+		t.Errorf("round trip = %+v, want %+v", again, d)
+	}
+}
+
+func TestListDomainsTyped(t *testing.T) {
+	srv, client := newServer(t)
+	expires := time.Date(2030, 1, 2, 3, 4, 5, 0, time.UTC)
+	srv.AddDomain(porkbun.Domain{Domain: "example.net", ExpireDate: expires, WhoisPrivacy: true})
+
+	domains, err := client.ListDomainsContext(context.Background())
+	if err != nil {
+		t.Fatalf("ListDomains: %v", err)
+	}
+	if len(domains) != 2 {
+		t.Fatalf("ListDomains = %+v, want 2 domains", domains)
+	}
+	d := domains[1]
+	if !d.ExpireDate.Equal(expires) || !d.WhoisPrivacy || d.SecurityLock || !domains[0].ExpireDate.IsZero() {
+		t.Errorf("ListDomains = %+v, want example.net expiring %s with WHOIS privacy", domains, expires)
+	}
+}
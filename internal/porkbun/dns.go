@@ -14,17 +14,52 @@
 
 package porkbun
 
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+)
 
-// DNSRecord represents a single DNS record in Porkbun.
+// DNSRecord represents a single DNS record in Porkbun. TTL is in seconds
+// and Prio is 0 for record types without a priority.
 type DNSRecord struct {
-	ID      interface{} `json:"id"`
-	Name    string      `json:"name"`
-	Type    string      `json:"type"`
-	Content string      `json:"content"`
-	TTL     string      `json:"ttl"`
-	Prio    string      `json:"prio"`
-	Notes   string      `json:"notes"`
+	ID      string `json:"id"`
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Content string `json:"content"`
+	TTL     int    `json:"ttl"`
+	Prio    int    `json:"prio"`
+	Notes   string `json:"notes"`
+}
+
+// UnmarshalJSON decodes a record as Porkbun sends it, with the ID as a
+// number or string and TTL and priority as strings, as well as the typed
+// form DNSRecord marshals to.
+func (r *DNSRecord) UnmarshalJSON(data []byte) error {
+	type plain DNSRecord
+	var raw struct {
+		plain
+		ID   json.RawMessage `json:"id"`
+		TTL  json.RawMessage `json:"ttl"`
+		Prio json.RawMessage `json:"prio"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	rec := DNSRecord(raw.plain)
+	var err error
+	if rec.ID, err = decodeString(raw.ID); err != nil {
+		return fmt.Errorf("id: %w", err)
+	}
+	if rec.TTL, err = decodeInt(raw.TTL, "ttl"); err != nil {
+		return err
+	}
+	if rec.Prio, err = decodeInt(raw.Prio, "prio"); err != nil {
+		return err
+	}
+	*r = rec
+	return nil
 }
 
 // RetrieveDNSResponse is the response from the DNS retrieve endpoint.
@@ -41,64 +76,221 @@ type CreateRecordRequest struct {
 	Content string `json:"content"`
 	TTL     string `json:"ttl,omitempty"`
 	Prio    string `json:"prio,omitempty"`
+	Notes   string `json:"notes,omitempty"`
 }
 
 // CreateRecordResponse is the response from the DNS create endpoint.
 type CreateRecordResponse struct {
 	APIResponse
-	ID interface{} `json:"id"`
+	ID json.Number `json:"id"`
+}
+
+// EditRecordRequest is the request body for editing a DNS record. Porkbun
+// requires name, type and content on every edit; Notes is a pointer because
+// a null value leaves the existing notes untouched while "" clears them.
+type EditRecordRequest struct {
+	BaseRequest
+	Name    string  `json:"name"`
+	Type    string  `json:"type"`
+	Content string  `json:"content"`
+	TTL     string  `json:"ttl,omitempty"`
+	Prio    string  `json:"prio,omitempty"`
+	Notes   *string `json:"notes"`
+}
+
+// Subdomain returns name relative to domain, which is the form the create and
+// edit endpoints expect. Porkbun returns fully-qualified names on retrieval,
+// so "www.example.com" becomes "www" and "example.com" becomes "".
+func Subdomain(name, domain string) string {
+	name = strings.TrimSuffix(strings.ToLower(name), ".")
+	domain = strings.TrimSuffix(strings.ToLower(domain), ".")
+	if name == domain {
+		return ""
+	}
+	return strings.TrimSuffix(name, "."+domain)
+}
+
+// SameContent compares record content, ignoring case and trailing dots for
+// types whose content is a hostname.
+func SameContent(typ, a, b string) bool {
+	return NormalizeContent(typ, a) == NormalizeContent(typ, b)
+}
+
+// NormalizeContent returns content in the form SameContent compares:
+// hostnames are lowercased and lose their trailing dot, and other content
+// is left alone.
+func NormalizeContent(typ, content string) string {
+	switch strings.ToUpper(typ) {
+	case "CNAME", "ALIAS", "MX", "NS":
+		return strings.ToLower(strings.TrimSuffix(content, "."))
+	}
+	return content
 }
 
 // RetrieveRecords fetches all DNS records for the given domain.
 func (c *Client) RetrieveRecords(domain string) ([]DNSRecord, error) {
+	return c.RetrieveRecordsContext(context.Background(), domain)
+}
+
+// RetrieveRecordsContext is like RetrieveRecords but uses ctx for the request.
+func (c *Client) RetrieveRecordsContext(ctx context.Context, domain string) ([]DNSRecord, error) {
 	req := BaseRequest{
 		APIKey:       c.APIKey,
 		SecretAPIKey: c.SecretAPIKey,
 	}
 	var res RetrieveDNSResponse
 	endpoint := fmt.Sprintf("dns/retrieve/%s", domain)
-	err := c.post(endpoint, req, &res)
+	err := c.post(ctx, endpoint, req, &res)
 	if err != nil {
 		return nil, err
 	}
 	return res.Records, nil
 }
 
+// RetrieveRecord fetches a single DNS record by its ID.
+func (c *Client) RetrieveRecord(domain, id string) (*DNSRecord, error) {
+	return c.RetrieveRecordContext(context.Background(), domain, id)
+}
+
+// RetrieveRecordContext is like RetrieveRecord but uses ctx for the request.
+func (c *Client) RetrieveRecordContext(ctx context.Context, domain, id string) (*DNSRecord, error) {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res RetrieveDNSResponse
+	endpoint := fmt.Sprintf("dns/retrieve/%s/%s", domain, id)
+	err := c.post(ctx, endpoint, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	if len(res.Records) == 0 {
+		return nil, fmt.Errorf("record %s on %s: %w", id, domain, ErrNotFound)
+	}
+	return &res.Records[0], nil
+}
+
 // CreateRecord creates a new DNS record for the given domain.
 func (c *Client) CreateRecord(domain string, record CreateRecordRequest) (string, error) {
+	return c.CreateRecordContext(context.Background(), domain, record)
+}
+
+// CreateRecordContext is like CreateRecord but uses ctx for the request.
+func (c *Client) CreateRecordContext(ctx context.Context, domain string, record CreateRecordRequest) (string, error) {
 	record.APIKey = c.APIKey
 	record.SecretAPIKey = c.SecretAPIKey
 
 	var res CreateRecordResponse
 	endpoint := fmt.Sprintf("dns/create/%s", domain)
-	err := c.post(endpoint, record, &res)
+	err := c.post(ctx, endpoint, record, &res)
 	if err != nil {
 		return "", err
 	}
-	if res.Status != "SUCCESS" {
-		msg := res.Message
-		if msg == "" {
-			msg = "unknown error"
-		}
-		return "", fmt.Errorf("api error: %s", msg)
-	}
-	return fmt.Sprintf("%v", res.ID), nil
+	return res.ID.String(), nil
+}
+
+// EditRecord replaces the name, type, content, TTL and priority of an existing
+// DNS record in place, keeping its ID.
+func (c *Client) EditRecord(domain, id string, record EditRecordRequest) error {
+	return c.EditRecordContext(context.Background(), domain, id, record)
+}
+
+// EditRecordContext is like EditRecord but uses ctx for the request.
+func (c *Client) EditRecordContext(ctx context.Context, domain, id string, record EditRecordRequest) error {
+	record.APIKey = c.APIKey
+	record.SecretAPIKey = c.SecretAPIKey
+
+	var res APIResponse
+	endpoint := fmt.Sprintf("dns/edit/%s/%s", domain, id)
+	return c.post(ctx, endpoint, record, &res)
 }
 
 // DeleteRecord deletes the specified DNS record from the given domain.
 func (c *Client) DeleteRecord(domain, id string) error {
+	return c.DeleteRecordContext(context.Background(), domain, id)
+}
+
+// DeleteRecordContext is like DeleteRecord but uses ctx for the request.
+func (c *Client) DeleteRecordContext(ctx context.Context, domain, id string) error {
 	req := BaseRequest{
 		APIKey:       c.APIKey,
 		SecretAPIKey: c.SecretAPIKey,
 	}
 	var res APIResponse
 	endpoint := fmt.Sprintf("dns/delete/%s/%s", domain, id)
-	err := c.post(endpoint, req, &res)
+	return c.post(ctx, endpoint, req, &res)
+}
+
+// EditByNameTypeRequest is the request body for editing every record with a
+// given name and type. As with dns/edit, Porkbun resets an empty TTL to 600
+// and an empty Prio to 0, so pass the records' current values to keep them.
+// A nil Notes leaves their notes unchanged.
+type EditByNameTypeRequest struct {
+	BaseRequest
+	Content string  `json:"content"`
+	TTL     string  `json:"ttl,omitempty"`
+	Prio    string  `json:"prio,omitempty"`
+	Notes   *string `json:"notes,omitempty"`
+}
+
+// nameTypeEndpoint builds the path for the *ByNameType endpoints. subdomain
+// is relative to the domain; "" selects the root.
+func nameTypeEndpoint(action, domain, typ, subdomain string) string {
+	endpoint := fmt.Sprintf("dns/%s/%s/%s", action, domain, strings.ToUpper(typ))
+	if subdomain != "" {
+		endpoint += "/" + subdomain
+	}
+	return endpoint
+}
+
+// RetrieveRecordsByNameType fetches the records of type typ at subdomain,
+// which is relative to the domain ("" for the root).
+func (c *Client) RetrieveRecordsByNameType(domain, typ, subdomain string) ([]DNSRecord, error) {
+	return c.RetrieveRecordsByNameTypeContext(context.Background(), domain, typ, subdomain)
+}
+
+// RetrieveRecordsByNameTypeContext is like RetrieveRecordsByNameType but uses
+// ctx for the request.
+func (c *Client) RetrieveRecordsByNameTypeContext(ctx context.Context, domain, typ, subdomain string) ([]DNSRecord, error) {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res RetrieveDNSResponse
+	err := c.post(ctx, nameTypeEndpoint("retrieveByNameType", domain, typ, subdomain), req, &res)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	if res.Status != "SUCCESS" {
-		return fmt.Errorf("delete record failed: %s", res.Message)
+	return res.Records, nil
+}
+
+// EditRecordsByNameType updates every record of type typ at subdomain.
+func (c *Client) EditRecordsByNameType(domain, typ, subdomain string, record EditByNameTypeRequest) error {
+	return c.EditRecordsByNameTypeContext(context.Background(), domain, typ, subdomain, record)
+}
+
+// EditRecordsByNameTypeContext is like EditRecordsByNameType but uses ctx for
+// the request.
+func (c *Client) EditRecordsByNameTypeContext(ctx context.Context, domain, typ, subdomain string, record EditByNameTypeRequest) error {
+	record.APIKey = c.APIKey
+	record.SecretAPIKey = c.SecretAPIKey
+
+	var res APIResponse
+	return c.post(ctx, nameTypeEndpoint("editByNameType", domain, typ, subdomain), record, &res)
+}
+
+// DeleteRecordsByNameType deletes every record of type typ at subdomain.
+func (c *Client) DeleteRecordsByNameType(domain, typ, subdomain string) error {
+	return c.DeleteRecordsByNameTypeContext(context.Background(), domain, typ, subdomain)
+}
+
+// DeleteRecordsByNameTypeContext is like DeleteRecordsByNameType but uses ctx
+// for the request.
+func (c *Client) DeleteRecordsByNameTypeContext(ctx context.Context, domain, typ, subdomain string) error {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
 	}
-	return nil
+	var res APIResponse
+	return c.post(ctx, nameTypeEndpoint("deleteByNameType", domain, typ, subdomain), req, &res)
 }
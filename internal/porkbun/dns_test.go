@@ -0,0 +1,84 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+func TestRecordsByNameType(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+	srv.AddRecord("example.com", porkbun.DNSRecord{Name: "www", Type: "A", Content: "192.0.2.1"})
+	srv.AddRecord("example.com", porkbun.DNSRecord{Name: "www", Type: "A", Content: "192.0.2.2"})
+	srv.AddRecord("example.com", porkbun.DNSRecord{Name: "www", Type: "AAAA", Content: "2001:db8::1"})
+	srv.AddRecord("example.com", porkbun.DNSRecord{Name: "", Type: "A", Content: "192.0.2.3"})
+
+	www, err := client.RetrieveRecordsByNameTypeContext(ctx, "example.com", "a", "www")
+	if err != nil {
+		t.Fatalf("RetrieveRecordsByNameType: %v", err)
+	}
+	if len(www) != 2 {
+		t.Fatalf("RetrieveRecordsByNameType(A, www) = %+v, want 2 records", www)
+	}
+	root, err := client.RetrieveRecordsByNameTypeContext(ctx, "example.com", "A", "")
+	if err != nil {
+		t.Fatalf("RetrieveRecordsByNameType: %v", err)
+	}
+	if len(root) != 1 || root[0].Content != "192.0.2.3" {
+		t.Fatalf("RetrieveRecordsByNameType(A, root) = %+v, want 192.0.2.3", root)
+	}
+
+	notes := "moved"
+	err = client.EditRecordsByNameTypeContext(ctx, "example.com", "A", "www", porkbun.EditByNameTypeRequest{
+		Content: "192.0.2.9",
+		TTL:     "1200",
+		Notes:   &notes,
+	})
+	if err != nil {
+		t.Fatalf("EditRecordsByNameType: %v", err)
+	}
+	for _, r := range srv.Records("example.com") {
+		edited := r.Name == "www.example.com" && r.Type == "A"
+		if edited && (r.Content != "192.0.2.9" || r.TTL != 1200 || r.Notes != "moved") {
+			t.Errorf("record %s not edited: %+v", r.ID, r)
+		}
+		if !edited && r.Content == "192.0.2.9" {
+			t.Errorf("edit touched an unrelated record: %+v", r)
+		}
+	}
+
+	// Like Porkbun, the fake resets a TTL the request leaves out.
+	err = client.EditRecordsByNameTypeContext(ctx, "example.com", "A", "www", porkbun.EditByNameTypeRequest{Content: "192.0.2.9"})
+	if err != nil {
+		t.Fatalf("EditRecordsByNameType: %v", err)
+	}
+	for _, r := range srv.Records("example.com") {
+		if r.Name == "www.example.com" && r.Type == "A" && r.TTL != porkbun.MinTTL {
+			t.Errorf("record %s kept TTL %d without one in the request, want %d", r.ID, r.TTL, porkbun.MinTTL)
+		}
+	}
+
+	if err := client.DeleteRecordsByNameTypeContext(ctx, "example.com", "A", "www"); err != nil {
+		t.Fatalf("DeleteRecordsByNameType: %v", err)
+	}
+	left := srv.Records("example.com")
+	if len(left) != 2 {
+		t.Fatalf("records after delete = %+v, want the AAAA and root A records", left)
+	}
+}
@@ -0,0 +1,171 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// DSRecord is a DNSSEC delegation signer record registered for a domain.
+// The KeyData fields are optional and only needed by registries that want
+// the DNSKEY itself.
+type DSRecord struct {
+	KeyTag          string `json:"keyTag"`
+	Alg             string `json:"alg"`
+	DigestType      string `json:"digestType"`
+	Digest          string `json:"digest"`
+	MaxSigLife      string `json:"maxSigLife,omitempty"`
+	KeyDataFlags    string `json:"keyDataFlags,omitempty"`
+	KeyDataProtocol string `json:"keyDataProtocol,omitempty"`
+	KeyDataAlgo     string `json:"keyDataAlgo,omitempty"`
+	KeyDataPubKey   string `json:"keyDataPubKey,omitempty"`
+}
+
+// CreateDnssecRecordRequest is the request body for creating a DS record.
+type CreateDnssecRecordRequest struct {
+	BaseRequest
+	DSRecord
+}
+
+// GetDnssecRecordsResponse is the response from the getDnssecRecords
+// endpoint. Records are keyed by key tag.
+type GetDnssecRecordsResponse struct {
+	APIResponse
+	Records dsRecordSet `json:"records"`
+}
+
+// dsRecordSet decodes the records field, which is an object keyed by key
+// tag, or an empty array when the domain has none.
+type dsRecordSet map[string]DSRecord
+
+func (s *dsRecordSet) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("[")) {
+		var list []DSRecord
+		if err := json.Unmarshal(data, &list); err != nil {
+			return err
+		}
+		*s = make(dsRecordSet, len(list))
+		for _, r := range list {
+			(*s)[r.KeyTag] = r
+		}
+		return nil
+	}
+	return json.Unmarshal(data, (*map[string]DSRecord)(s))
+}
+
+// GetDnssecRecords retrieves the DS records registered for a domain,
+// ordered by key tag.
+func (c *Client) GetDnssecRecords(domain string) ([]DSRecord, error) {
+	return c.GetDnssecRecordsContext(context.Background(), domain)
+}
+
+// GetDnssecRecordsContext is like GetDnssecRecords but uses ctx for the
+// request.
+func (c *Client) GetDnssecRecordsContext(ctx context.Context, domain string) ([]DSRecord, error) {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res GetDnssecRecordsResponse
+	endpoint := fmt.Sprintf("dns/getDnssecRecords/%s", domain)
+	err := c.post(ctx, endpoint, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	records := make([]DSRecord, 0, len(res.Records))
+	for tag, r := range res.Records {
+		if r.KeyTag == "" {
+			r.KeyTag = tag
+		}
+		records = append(records, r)
+	}
+	slices.SortFunc(records, func(a, b DSRecord) int {
+		x, _ := strconv.Atoi(a.KeyTag)
+		y, _ := strconv.Atoi(b.KeyTag)
+		return x - y
+	})
+	return records, nil
+}
+
+// CreateDnssecRecord registers a DS record for a domain at the registry.
+func (c *Client) CreateDnssecRecord(domain string, ds DSRecord) error {
+	return c.CreateDnssecRecordContext(context.Background(), domain, ds)
+}
+
+// CreateDnssecRecordContext is like CreateDnssecRecord but uses ctx for the
+// request.
+func (c *Client) CreateDnssecRecordContext(ctx context.Context, domain string, ds DSRecord) error {
+	if err := ValidateDSRecord(ds); err != nil {
+		return err
+	}
+	req := CreateDnssecRecordRequest{
+		BaseRequest: BaseRequest{
+			APIKey:       c.APIKey,
+			SecretAPIKey: c.SecretAPIKey,
+		},
+		DSRecord: ds,
+	}
+	var res APIResponse
+	endpoint := fmt.Sprintf("dns/createDnssecRecord/%s", domain)
+	return c.post(ctx, endpoint, req, &res)
+}
+
+// DeleteDnssecRecord removes the DS record with the given key tag.
+func (c *Client) DeleteDnssecRecord(domain, keyTag string) error {
+	return c.DeleteDnssecRecordContext(context.Background(), domain, keyTag)
+}
+
+// DeleteDnssecRecordContext is like DeleteDnssecRecord but uses ctx for the
+// request.
+func (c *Client) DeleteDnssecRecordContext(ctx context.Context, domain, keyTag string) error {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res APIResponse
+	endpoint := fmt.Sprintf("dns/deleteDnssecRecord/%s/%s", domain, keyTag)
+	return c.post(ctx, endpoint, req, &res)
+}
+
+// dsDigestBytes maps digest types to the size of their digests.
+var dsDigestBytes = map[string]int{"1": 20, "2": 32, "4": 48}
+
+// ValidateDSRecord checks the key tag, algorithm, digest type and digest of
+// a DS record. Errors match ErrInvalidRequest.
+func ValidateDSRecord(ds DSRecord) error {
+	if err := validateDSRecord(ds); err != nil {
+		return &validationError{err: err}
+	}
+	return nil
+}
+
+func validateDSRecord(ds DSRecord) error {
+	if err := validateUint(ds.KeyTag, "key tag", 65535); err != nil {
+		return err
+	}
+	if err := validateUint(ds.Alg, "algorithm", 255); err != nil {
+		return err
+	}
+	want, ok := dsDigestBytes[ds.DigestType]
+	if !ok {
+		return fmt.Errorf("digest type %q must be 1 (SHA-1), 2 (SHA-256) or 4 (SHA-384)", ds.DigestType)
+	}
+	return validateHex(ds.Digest, "digest", want)
+}
@@ -0,0 +1,90 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+func TestDnssecLifecycle(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+
+	// An empty set comes back from Porkbun as [] rather than {}.
+	records, err := client.GetDnssecRecordsContext(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("GetDnssecRecords with none: %v", err)
+	}
+	if len(records) != 0 {
+		t.Fatalf("GetDnssecRecords = %+v, want none", records)
+	}
+
+	ds := porkbun.DSRecord{
+		KeyTag:     "60485",
+		Alg:        "5",
+		DigestType: "2",
+		Digest:     "D4B7D520E7BB5F0F67674A0CCEB1E3E0614B93C4F9E99B8383F6A1E4469DA50A",
+	}
+	if err := client.CreateDnssecRecordContext(ctx, "example.com", ds); err != nil {
+		t.Fatalf("CreateDnssecRecord: %v", err)
+	}
+	records, err = client.GetDnssecRecordsContext(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("GetDnssecRecords: %v", err)
+	}
+	if len(records) != 1 || records[0] != ds {
+		t.Fatalf("GetDnssecRecords = %+v, want [%+v]", records, ds)
+	}
+
+	if err := client.DeleteDnssecRecordContext(ctx, "example.com", "60485"); err != nil {
+		t.Fatalf("DeleteDnssecRecord: %v", err)
+	}
+	if got := srv.DSRecords("example.com"); len(got) != 0 {
+		t.Errorf("DS records after delete = %+v, want none", got)
+	}
+}
+
+func TestValidateDSRecord(t *testing.T) {
+	valid := porkbun.DSRecord{KeyTag: "2371", Alg: "13", DigestType: "2", Digest: strings.Repeat("ab", 32)}
+	tests := []struct {
+		name   string
+		change func(*porkbun.DSRecord)
+		ok     bool
+	}{
+		{"valid", func(*porkbun.DSRecord) {}, true},
+		{"SHA-384", func(ds *porkbun.DSRecord) { ds.DigestType, ds.Digest = "4", strings.Repeat("ab", 48) }, true},
+		{"short digest", func(ds *porkbun.DSRecord) { ds.Digest = "abcd" }, false},
+		{"unknown digest type", func(ds *porkbun.DSRecord) { ds.DigestType = "3" }, false},
+		{"key tag too large", func(ds *porkbun.DSRecord) { ds.KeyTag = "65536" }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ds := valid
+			tt.change(&ds)
+			err := porkbun.ValidateDSRecord(ds)
+			if tt.ok && err != nil {
+				t.Errorf("ValidateDSRecord: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, porkbun.ErrInvalidRequest) {
+				t.Errorf("ValidateDSRecord: err = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
@@ -1,19 +1,72 @@
 package porkbun
 
-import "fmt"
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"iter"
+	"strconv"
+	"time"
+)
+
+// listAllPageSize is the number of domains domain/listAll returns per call.
+const listAllPageSize = 1000
 
 // Domain represents a domain registered with Porkbun.
 type Domain struct {
-	Domain       string      `json:"domain"`
-	Status       string      `json:"status"`
-	TLD          string      `json:"tld"`
-	CreateDate   string      `json:"createDate"`
-	ExpireDate   string      `json:"expireDate"`
-	SecurityLock string      `json:"securityLock"`
-	WhoisPrivacy string      `json:"whoisPrivacy"`
-	AutoRenew    interface{} `json:"autoRenew"`
-	NotLocal     interface{} `json:"notLocal"`
-	Labels       []Label     `json:"labels"`
+	Domain       string    `json:"domain"`
+	Status       string    `json:"status"`
+	TLD          string    `json:"tld"`
+	CreateDate   time.Time `json:"createDate,omitzero"`
+	ExpireDate   time.Time `json:"expireDate,omitzero"`
+	SecurityLock bool      `json:"securityLock"`
+	WhoisPrivacy bool      `json:"whoisPrivacy"`
+	AutoRenew    bool      `json:"autoRenew"`
+	NotLocal     bool      `json:"notLocal"`
+	Labels       []Label   `json:"labels"`
+}
+
+// UnmarshalJSON decodes a domain as Porkbun sends it, with dates in
+// Porkbun's "2006-01-02 15:04:05" layout and flags as "1"/"0" or numbers,
+// as well as the typed form Domain marshals to.
+func (d *Domain) UnmarshalJSON(data []byte) error {
+	type plain Domain
+	var raw struct {
+		plain
+		CreateDate   json.RawMessage `json:"createDate"`
+		ExpireDate   json.RawMessage `json:"expireDate"`
+		SecurityLock json.RawMessage `json:"securityLock"`
+		WhoisPrivacy json.RawMessage `json:"whoisPrivacy"`
+		AutoRenew    json.RawMessage `json:"autoRenew"`
+		NotLocal     json.RawMessage `json:"notLocal"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	dom := Domain(raw.plain)
+	var err error
+	if dom.CreateDate, err = decodeTime(raw.CreateDate, "createDate"); err != nil {
+		return err
+	}
+	if dom.ExpireDate, err = decodeTime(raw.ExpireDate, "expireDate"); err != nil {
+		return err
+	}
+	for _, f := range []struct {
+		dst  *bool
+		raw  json.RawMessage
+		name string
+	}{
+		{&dom.SecurityLock, raw.SecurityLock, "securityLock"},
+		{&dom.WhoisPrivacy, raw.WhoisPrivacy, "whoisPrivacy"},
+		{&dom.AutoRenew, raw.AutoRenew, "autoRenew"},
+		{&dom.NotLocal, raw.NotLocal, "notLocal"},
+	} {
+		if *f.dst, err = decodeBool(f.raw, f.name); err != nil {
+			return err
+		}
+	}
+	*d = dom
+	return nil
 }
 
 // Label represents a user-defined label in Porkbun.
@@ -38,15 +91,75 @@ type ListDomainsResponse struct {
 
 // ListDomains fetches all domains in the user's Porkbun account.
 func (c *Client) ListDomains() ([]Domain, error) {
+	return c.ListDomainsContext(context.Background())
+}
+
+// ListDomainsContext is like ListDomains but uses ctx for the requests.
+func (c *Client) ListDomainsContext(ctx context.Context) ([]Domain, error) {
+	var domains []Domain
+	for d, err := range c.DomainsContext(ctx) {
+		if err != nil {
+			return nil, err
+		}
+		domains = append(domains, d)
+	}
+	return domains, nil
+}
+
+// Domains returns an iterator over all domains in the user's Porkbun
+// account. It fetches them a page at a time, so large accounts can be
+// processed without holding every domain in memory.
+func (c *Client) Domains() iter.Seq2[Domain, error] {
+	return c.DomainsContext(context.Background())
+}
+
+// DomainsContext is like Domains but uses ctx for the requests. If a page
+// can't be fetched, the iterator yields the error and stops.
+func (c *Client) DomainsContext(ctx context.Context) iter.Seq2[Domain, error] {
+	return func(yield func(Domain, error) bool) {
+		var first string
+		for start := 0; ; start += listAllPageSize {
+			page, err := c.listDomainsPage(ctx, start)
+			if err != nil {
+				yield(Domain{}, err)
+				return
+			}
+			if len(page) == 0 {
+				return
+			}
+			// A server or proxy that ignores start would hand back the
+			// same page forever.
+			if start > 0 && page[0].Domain == first {
+				yield(Domain{}, fmt.Errorf("domain/listAll: page at %d repeats the previous page", start))
+				return
+			}
+			first = page[0].Domain
+			for _, d := range page {
+				if !yield(d, nil) {
+					return
+				}
+			}
+			// A short page is the last one.
+			if len(page) < listAllPageSize {
+				return
+			}
+		}
+	}
+}
+
+// listDomainsPage fetches up to listAllPageSize domains beginning at the
+// given index.
+func (c *Client) listDomainsPage(ctx context.Context, start int) ([]Domain, error) {
 	req := ListDomainsRequest{
 		BaseRequest: BaseRequest{
 			APIKey:       c.APIKey,
 			SecretAPIKey: c.SecretAPIKey,
 		},
+		Start:         strconv.Itoa(start),
 		IncludeLabels: "yes",
 	}
 	var res ListDomainsResponse
-	err := c.post("domain/listAll", req, &res)
+	err := c.post(ctx, "domain/listAll", req, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -68,13 +181,18 @@ type DomainCheckResponse struct {
 
 // CheckDomain checks the availability and pricing of a domain.
 func (c *Client) CheckDomain(domain string) (*DomainCheckResponse, error) {
+	return c.CheckDomainContext(context.Background(), domain)
+}
+
+// CheckDomainContext is like CheckDomain but uses ctx for the request.
+func (c *Client) CheckDomainContext(ctx context.Context, domain string) (*DomainCheckResponse, error) {
 	req := BaseRequest{
 		APIKey:       c.APIKey,
 		SecretAPIKey: c.SecretAPIKey,
 	}
 	var res DomainCheckResponse
 	endpoint := fmt.Sprintf("domain/checkDomain/%s", domain)
-	err := c.post(endpoint, req, &res)
+	err := c.post(ctx, endpoint, req, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -96,12 +214,17 @@ type PricingResponse struct {
 
 // GetPricing retrieves pricing information for all supported TLDs.
 func (c *Client) GetPricing() (*PricingResponse, error) {
+	return c.GetPricingContext(context.Background())
+}
+
+// GetPricingContext is like GetPricing but uses ctx for the request.
+func (c *Client) GetPricingContext(ctx context.Context) (*PricingResponse, error) {
 	req := BaseRequest{
 		APIKey:       c.APIKey,
 		SecretAPIKey: c.SecretAPIKey,
 	}
 	var res PricingResponse
-	err := c.post("pricing/get", req, &res)
+	err := c.post(ctx, "pricing/get", req, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,69 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+// ignoreStart serves the same n domains for every listAll request, like a
+// server or proxy that drops the start parameter.
+func ignoreStart(t *testing.T, n int) (*porkbun.Client, *int) {
+	t.Helper()
+	calls := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		calls++
+		domains := make([]map[string]string, n)
+		for i := range domains {
+			domains[i] = map[string]string{"domain": fmt.Sprintf("d%04d.example", i)}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]any{"status": "SUCCESS", "domains": domains})
+	}))
+	t.Cleanup(srv.Close)
+	client := porkbun.NewClient("pk1", "sk1", porkbun.WithBaseURL(srv.URL))
+	client.Retry = porkbun.RetryPolicy{}
+	return client, &calls
+}
+
+func TestListDomainsShortPage(t *testing.T) {
+	client, calls := ignoreStart(t, 3)
+
+	domains, err := client.ListDomainsContext(context.Background())
+	if err != nil {
+		t.Fatalf("ListDomains: %v", err)
+	}
+	if len(domains) != 3 || *calls != 1 {
+		t.Errorf("got %d domains in %d requests, want 3 in 1", len(domains), *calls)
+	}
+}
+
+func TestListDomainsRepeatedPage(t *testing.T) {
+	client, calls := ignoreStart(t, 1000)
+
+	_, err := client.ListDomainsContext(context.Background())
+	if err == nil {
+		t.Fatal("ListDomains succeeded on a server that repeats its first page")
+	}
+	if *calls != 2 {
+		t.Errorf("made %d requests, want 2", *calls)
+	}
+}
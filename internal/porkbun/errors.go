@@ -0,0 +1,103 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+)
+
+// Sentinel errors classifying API failures. Use errors.Is to check for them;
+// an *APIError matches the sentinel for its kind of failure.
+var (
+	// ErrUnauthorized means the API key or secret was rejected, or the
+	// domain isn't opted in to API access.
+	ErrUnauthorized = errors.New("unauthorized")
+	// ErrRateLimited means Porkbun rejected the request for exceeding a
+	// rate limit.
+	ErrRateLimited = errors.New("rate limited")
+	// ErrNotFound means the domain or record doesn't exist.
+	ErrNotFound = errors.New("not found")
+	// ErrInvalidRequest means the request was malformed or failed
+	// validation, either locally or at Porkbun.
+	ErrInvalidRequest = errors.New("invalid request")
+	// ErrOffline means the client's cache is offline and doesn't hold a
+	// response for the request.
+	ErrOffline = errors.New("not available offline")
+)
+
+// APIError is returned when Porkbun answers with a non-200 response or with
+// an ERROR status in the response body.
+type APIError struct {
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Status is the "status" field of the response body, usually "ERROR".
+	Status string
+	// Message is the "message" field of the response body, or the raw body
+	// if it wasn't JSON.
+	Message string
+	// Endpoint is the API path that was called, e.g. "dns/create/example.com".
+	Endpoint string
+
+	retryAfter time.Duration
+}
+
+func (e *APIError) Error() string {
+	if e.StatusCode != http.StatusOK {
+		return fmt.Sprintf("api error: %s: %s (status %d)", e.Endpoint, e.Message, e.StatusCode)
+	}
+	return fmt.Sprintf("api error: %s: %s", e.Endpoint, e.Message)
+}
+
+// Unwrap returns the sentinel error matching the kind of failure, so that
+// errors.Is(err, ErrNotFound) and friends work. It returns nil for failures
+// that don't fit any of them.
+func (e *APIError) Unwrap() error {
+	msg := strings.ToLower(e.Message)
+	switch {
+	case e.StatusCode == http.StatusTooManyRequests, isRateLimitMessage(e.Message):
+		return ErrRateLimited
+	case e.StatusCode == http.StatusUnauthorized || e.StatusCode == http.StatusForbidden,
+		strings.Contains(msg, "api key"),
+		strings.Contains(msg, "secret"),
+		strings.Contains(msg, "authentication"),
+		strings.Contains(msg, "unauthorized"),
+		strings.Contains(msg, "opted in to api access"):
+		return ErrUnauthorized
+	case e.StatusCode == http.StatusNotFound,
+		strings.Contains(msg, "not found"),
+		strings.Contains(msg, "does not exist"),
+		strings.Contains(msg, "invalid record id"),
+		strings.Contains(msg, "invalid domain"):
+		return ErrNotFound
+	case e.StatusCode == http.StatusBadRequest || e.StatusCode == http.StatusUnprocessableEntity,
+		strings.Contains(msg, "invalid"):
+		return ErrInvalidRequest
+	}
+	return nil
+}
+
+// validationError marks a locally detected problem with a request as
+// ErrInvalidRequest while keeping its own message.
+type validationError struct {
+	err error
+}
+
+func (e *validationError) Error() string { return e.err.Error() }
+
+func (e *validationError) Unwrap() []error { return []error{e.err, ErrInvalidRequest} }
@@ -0,0 +1,137 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+)
+
+// URL forward types.
+const (
+	ForwardPermanent = "permanent" // HTTP 301
+	ForwardTemporary = "temporary" // HTTP 302
+)
+
+// URLForward redirects web requests for a domain or subdomain to another
+// URL. IncludePath and Wildcard are "yes" or "no".
+type URLForward struct {
+	ID          string `json:"id,omitempty"`
+	Subdomain   string `json:"subdomain"`
+	Location    string `json:"location"`
+	Type        string `json:"type"`
+	IncludePath string `json:"includePath"`
+	Wildcard    string `json:"wildcard"`
+}
+
+// AddURLForwardRequest is the request body for the addUrlForward endpoint.
+type AddURLForwardRequest struct {
+	BaseRequest
+	Subdomain   string `json:"subdomain"`
+	Location    string `json:"location"`
+	Type        string `json:"type"`
+	IncludePath string `json:"includePath"`
+	Wildcard    string `json:"wildcard"`
+}
+
+// URLForwardingResponse is the response from the getUrlForwarding endpoint.
+type URLForwardingResponse struct {
+	APIResponse
+	Forwards []URLForward `json:"forwards"`
+}
+
+// GetURLForwards retrieves the URL forwards configured for a domain.
+func (c *Client) GetURLForwards(domain string) ([]URLForward, error) {
+	return c.GetURLForwardsContext(context.Background(), domain)
+}
+
+// GetURLForwardsContext is like GetURLForwards but uses ctx for the request.
+func (c *Client) GetURLForwardsContext(ctx context.Context, domain string) ([]URLForward, error) {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res URLForwardingResponse
+	endpoint := fmt.Sprintf("domain/getUrlForwarding/%s", domain)
+	err := c.post(ctx, endpoint, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res.Forwards, nil
+}
+
+// AddURLForward adds a URL forward to a domain. The ID of f is ignored.
+func (c *Client) AddURLForward(domain string, f URLForward) error {
+	return c.AddURLForwardContext(context.Background(), domain, f)
+}
+
+// AddURLForwardContext is like AddURLForward but uses ctx for the request.
+func (c *Client) AddURLForwardContext(ctx context.Context, domain string, f URLForward) error {
+	if err := ValidateURLForward(f); err != nil {
+		return err
+	}
+	req := AddURLForwardRequest{
+		BaseRequest: BaseRequest{
+			APIKey:       c.APIKey,
+			SecretAPIKey: c.SecretAPIKey,
+		},
+		Subdomain:   f.Subdomain,
+		Location:    f.Location,
+		Type:        f.Type,
+		IncludePath: yesNo(f.IncludePath),
+		Wildcard:    yesNo(f.Wildcard),
+	}
+	var res APIResponse
+	endpoint := fmt.Sprintf("domain/addUrlForward/%s", domain)
+	return c.post(ctx, endpoint, req, &res)
+}
+
+// DeleteURLForward removes the URL forward with the given ID.
+func (c *Client) DeleteURLForward(domain, id string) error {
+	return c.DeleteURLForwardContext(context.Background(), domain, id)
+}
+
+// DeleteURLForwardContext is like DeleteURLForward but uses ctx for the
+// request.
+func (c *Client) DeleteURLForwardContext(ctx context.Context, domain, id string) error {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res APIResponse
+	endpoint := fmt.Sprintf("domain/deleteUrlForward/%s/%s", domain, id)
+	return c.post(ctx, endpoint, req, &res)
+}
+
+// ValidateURLForward checks the type and location of a URL forward. Errors
+// match ErrInvalidRequest.
+func ValidateURLForward(f URLForward) error {
+	if f.Type != ForwardPermanent && f.Type != ForwardTemporary {
+		return &validationError{err: fmt.Errorf("forward type %q must be %q (301) or %q (302)", f.Type, ForwardPermanent, ForwardTemporary)}
+	}
+	u, err := url.Parse(f.Location)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return &validationError{err: fmt.Errorf("forward location %q must be an absolute http or https URL", f.Location)}
+	}
+	return nil
+}
+
+func yesNo(v string) string {
+	if v == "yes" {
+		return "yes"
+	}
+	return "no"
+}
@@ -0,0 +1,95 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+func TestURLForwardLifecycle(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+
+	forwards, err := client.GetURLForwardsContext(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("GetURLForwards with none: %v", err)
+	}
+	if len(forwards) != 0 {
+		t.Fatalf("GetURLForwards = %+v, want none", forwards)
+	}
+
+	f := porkbun.URLForward{
+		Subdomain:   "www",
+		Location:    "https://example.net",
+		Type:        porkbun.ForwardPermanent,
+		IncludePath: "yes",
+		Wildcard:    "no",
+	}
+	if err := client.AddURLForwardContext(ctx, "example.com", f); err != nil {
+		t.Fatalf("AddURLForward: %v", err)
+	}
+	forwards, err = client.GetURLForwardsContext(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("GetURLForwards: %v", err)
+	}
+	if len(forwards) != 1 || forwards[0].ID == "" {
+		t.Fatalf("GetURLForwards = %+v, want one forward with an ID", forwards)
+	}
+	got := forwards[0]
+	f.ID = got.ID
+	if got != f {
+		t.Errorf("GetURLForwards = %+v, want %+v", got, f)
+	}
+
+	if err := client.DeleteURLForwardContext(ctx, "example.com", got.ID); err != nil {
+		t.Fatalf("DeleteURLForward: %v", err)
+	}
+	if left := srv.URLForwards("example.com"); len(left) != 0 {
+		t.Errorf("forwards after delete = %+v, want none", left)
+	}
+	if err := client.DeleteURLForwardContext(ctx, "example.com", got.ID); !errors.Is(err, porkbun.ErrNotFound) {
+		t.Errorf("deleting a missing forward: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestValidateURLForward(t *testing.T) {
+	valid := porkbun.URLForward{Location: "https://example.net", Type: porkbun.ForwardTemporary, IncludePath: "no", Wildcard: "no"}
+	tests := []struct {
+		name   string
+		change func(*porkbun.URLForward)
+		ok     bool
+	}{
+		{"valid", func(*porkbun.URLForward) {}, true},
+		{"relative location", func(f *porkbun.URLForward) { f.Location = "example.net/path" }, false},
+		{"unknown type", func(f *porkbun.URLForward) { f.Type = "masked" }, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			f := valid
+			tt.change(&f)
+			err := porkbun.ValidateURLForward(f)
+			if tt.ok && err != nil {
+				t.Errorf("ValidateURLForward: %v", err)
+			}
+			if !tt.ok && !errors.Is(err, porkbun.ErrInvalidRequest) {
+				t.Errorf("ValidateURLForward: err = %v, want ErrInvalidRequest", err)
+			}
+		})
+	}
+}
@@ -0,0 +1,157 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/netip"
+)
+
+// GlueRecord is a glue record: the addresses registered at the registry for
+// a nameserver host under the domain.
+type GlueRecord struct {
+	Host string   `json:"host"`
+	IPv4 []string `json:"v4"`
+	IPv6 []string `json:"v6"`
+}
+
+// UnmarshalJSON decodes the [host, {"v4": [...], "v6": [...]}] pairs that
+// the getGlue endpoint returns, as well as the object form GlueRecord
+// marshals to.
+func (g *GlueRecord) UnmarshalJSON(data []byte) error {
+	if bytes.HasPrefix(bytes.TrimSpace(data), []byte("{")) {
+		type plain GlueRecord
+		return json.Unmarshal(data, (*plain)(g))
+	}
+	var pair []json.RawMessage
+	if err := json.Unmarshal(data, &pair); err != nil || len(pair) != 2 {
+		return fmt.Errorf("unexpected glue record %s", data)
+	}
+	var addrs struct {
+		V4 []string `json:"v4"`
+		V6 []string `json:"v6"`
+	}
+	if err := json.Unmarshal(pair[0], &g.Host); err != nil {
+		return fmt.Errorf("unexpected glue host %s", pair[0])
+	}
+	if err := json.Unmarshal(pair[1], &addrs); err != nil {
+		return fmt.Errorf("unexpected glue addresses %s", pair[1])
+	}
+	g.IPv4, g.IPv6 = addrs.V4, addrs.V6
+	return nil
+}
+
+// GlueRequest is the request body for creating or updating a glue record.
+type GlueRequest struct {
+	BaseRequest
+	IPs []string `json:"ips"`
+}
+
+// GetGlueResponse is the response from the getGlue endpoint.
+type GetGlueResponse struct {
+	APIResponse
+	Hosts []GlueRecord `json:"hosts"`
+}
+
+// GetGlue retrieves the glue records for a domain.
+func (c *Client) GetGlue(domain string) ([]GlueRecord, error) {
+	return c.GetGlueContext(context.Background(), domain)
+}
+
+// GetGlueContext is like GetGlue but uses ctx for the request.
+func (c *Client) GetGlueContext(ctx context.Context, domain string) ([]GlueRecord, error) {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res GetGlueResponse
+	endpoint := fmt.Sprintf("domain/getGlue/%s", domain)
+	err := c.post(ctx, endpoint, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res.Hosts, nil
+}
+
+// CreateGlue creates a glue record for host, which may be given relative to
+// the domain (ns1) or fully qualified (ns1.example.com).
+func (c *Client) CreateGlue(domain, host string, ips []string) error {
+	return c.CreateGlueContext(context.Background(), domain, host, ips)
+}
+
+// CreateGlueContext is like CreateGlue but uses ctx for the request.
+func (c *Client) CreateGlueContext(ctx context.Context, domain, host string, ips []string) error {
+	return c.postGlue(ctx, "createGlue", domain, host, ips)
+}
+
+// UpdateGlue replaces the addresses of an existing glue record.
+func (c *Client) UpdateGlue(domain, host string, ips []string) error {
+	return c.UpdateGlueContext(context.Background(), domain, host, ips)
+}
+
+// UpdateGlueContext is like UpdateGlue but uses ctx for the request.
+func (c *Client) UpdateGlueContext(ctx context.Context, domain, host string, ips []string) error {
+	return c.postGlue(ctx, "updateGlue", domain, host, ips)
+}
+
+// DeleteGlue removes the glue record for host.
+func (c *Client) DeleteGlue(domain, host string) error {
+	return c.DeleteGlueContext(context.Background(), domain, host)
+}
+
+// DeleteGlueContext is like DeleteGlue but uses ctx for the request.
+func (c *Client) DeleteGlueContext(ctx context.Context, domain, host string) error {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res APIResponse
+	endpoint := fmt.Sprintf("domain/deleteGlue/%s/%s", domain, Subdomain(host, domain))
+	return c.post(ctx, endpoint, req, &res)
+}
+
+func (c *Client) postGlue(ctx context.Context, action, domain, host string, ips []string) error {
+	if err := ValidateGlueIPs(ips); err != nil {
+		return err
+	}
+	req := GlueRequest{
+		BaseRequest: BaseRequest{
+			APIKey:       c.APIKey,
+			SecretAPIKey: c.SecretAPIKey,
+		},
+		IPs: ips,
+	}
+	var res APIResponse
+	endpoint := fmt.Sprintf("domain/%s/%s/%s", action, domain, Subdomain(host, domain))
+	return c.post(ctx, endpoint, req, &res)
+}
+
+// ValidateGlueIPs checks that ips is a non-empty list of IPv4 and IPv6
+// addresses. Errors match ErrInvalidRequest.
+func ValidateGlueIPs(ips []string) error {
+	if len(ips) == 0 {
+		return &validationError{err: errors.New("a glue record needs at least one IP address")}
+	}
+	for _, ip := range ips {
+		if _, err := netip.ParseAddr(ip); err != nil {
+			return &validationError{err: fmt.Errorf("%q is not an IPv4 or IPv6 address", ip)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+func TestGlueLifecycle(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+
+	if err := client.CreateGlueContext(ctx, "example.com", "ns1", []string{"192.0.2.53", "2001:db8::53"}); err != nil {
+		t.Fatalf("CreateGlue: %v", err)
+	}
+	glue, err := client.GetGlueContext(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("GetGlue: %v", err)
+	}
+	if len(glue) != 1 || glue[0].Host != "ns1.example.com" ||
+		!slices.Equal(glue[0].IPv4, []string{"192.0.2.53"}) || !slices.Equal(glue[0].IPv6, []string{"2001:db8::53"}) {
+		t.Fatalf("GetGlue = %+v, want ns1.example.com with 192.0.2.53 and 2001:db8::53", glue)
+	}
+
+	// Fully qualified hosts work as well as relative ones.
+	if err := client.UpdateGlueContext(ctx, "example.com", "ns1.example.com", []string{"192.0.2.54"}); err != nil {
+		t.Fatalf("UpdateGlue: %v", err)
+	}
+	if got := srv.Glue("example.com"); len(got) != 1 || !slices.Equal(got[0].IPv4, []string{"192.0.2.54"}) || len(got[0].IPv6) != 0 {
+		t.Errorf("glue after update = %+v, want only 192.0.2.54", got)
+	}
+
+	if err := client.DeleteGlueContext(ctx, "example.com", "ns1"); err != nil {
+		t.Fatalf("DeleteGlue: %v", err)
+	}
+	if got := srv.Glue("example.com"); len(got) != 0 {
+		t.Errorf("glue after delete = %+v, want none", got)
+	}
+	if err := client.DeleteGlueContext(ctx, "example.com", "ns1"); !errors.Is(err, porkbun.ErrNotFound) {
+		t.Errorf("deleting missing glue: err = %v, want ErrNotFound", err)
+	}
+}
+
+func TestGlueValidation(t *testing.T) {
+	_, client := newServer(t)
+	for _, ips := range [][]string{nil, {"ns1.example.net"}} {
+		err := client.CreateGlueContext(context.Background(), "example.com", "ns1", ips)
+		if !errors.Is(err, porkbun.ErrInvalidRequest) {
+			t.Errorf("CreateGlue(%q): err = %v, want ErrInvalidRequest", ips, err)
+		}
+	}
+}
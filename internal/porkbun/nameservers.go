@@ -0,0 +1,108 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+)
+
+// DefaultNameservers are the nameservers Porkbun assigns to new domains.
+// Records managed through the DNS endpoints are only served while a domain
+// uses them.
+var DefaultNameservers = []string{
+	"curitiba.ns.porkbun.com",
+	"fortaleza.ns.porkbun.com",
+	"maceio.ns.porkbun.com",
+	"salvador.ns.porkbun.com",
+}
+
+// IsPorkbunNameserver reports whether host is one of Porkbun's nameservers.
+func IsPorkbunNameserver(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	return strings.HasSuffix(host, ".porkbun.com")
+}
+
+// UpdateNameserversRequest is the request body for the updateNs endpoint.
+type UpdateNameserversRequest struct {
+	BaseRequest
+	NS []string `json:"ns"`
+}
+
+// NameserversResponse is the response from the getNs endpoint.
+type NameserversResponse struct {
+	APIResponse
+	NS []string `json:"ns"`
+}
+
+// GetNameservers retrieves the authoritative nameservers registered for a
+// domain.
+func (c *Client) GetNameservers(domain string) ([]string, error) {
+	return c.GetNameserversContext(context.Background(), domain)
+}
+
+// GetNameserversContext is like GetNameservers but uses ctx for the request.
+func (c *Client) GetNameserversContext(ctx context.Context, domain string) ([]string, error) {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res NameserversResponse
+	endpoint := fmt.Sprintf("domain/getNs/%s", domain)
+	err := c.post(ctx, endpoint, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return res.NS, nil
+}
+
+// UpdateNameservers replaces the nameservers registered for a domain.
+func (c *Client) UpdateNameservers(domain string, ns []string) error {
+	return c.UpdateNameserversContext(context.Background(), domain, ns)
+}
+
+// UpdateNameserversContext is like UpdateNameservers but uses ctx for the
+// request.
+func (c *Client) UpdateNameserversContext(ctx context.Context, domain string, ns []string) error {
+	if err := ValidateNameservers(ns); err != nil {
+		return err
+	}
+	req := UpdateNameserversRequest{
+		BaseRequest: BaseRequest{
+			APIKey:       c.APIKey,
+			SecretAPIKey: c.SecretAPIKey,
+		},
+		NS: ns,
+	}
+	var res APIResponse
+	endpoint := fmt.Sprintf("domain/updateNs/%s", domain)
+	return c.post(ctx, endpoint, req, &res)
+}
+
+// ValidateNameservers checks that ns is a non-empty list of hostnames.
+// Errors match ErrInvalidRequest.
+func ValidateNameservers(ns []string) error {
+	if len(ns) == 0 {
+		return &validationError{err: errors.New("at least one nameserver is required")}
+	}
+	for _, host := range ns {
+		if err := validateHostname(host); err != nil {
+			return &validationError{err: fmt.Errorf("nameserver: %w", err)}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,57 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+func TestNameservers(t *testing.T) {
+	srv, client := newServer(t)
+	ctx := context.Background()
+
+	ns, err := client.GetNameserversContext(ctx, "example.com")
+	if err != nil {
+		t.Fatalf("GetNameservers: %v", err)
+	}
+	if !slices.Equal(ns, porkbun.DefaultNameservers) {
+		t.Errorf("GetNameservers = %q, want the Porkbun defaults", ns)
+	}
+	for _, host := range ns {
+		if !porkbun.IsPorkbunNameserver(host) {
+			t.Errorf("IsPorkbunNameserver(%q) = false", host)
+		}
+	}
+
+	want := []string{"ada.ns.cloudflare.com", "bob.ns.cloudflare.com"}
+	if err := client.UpdateNameserversContext(ctx, "example.com", want); err != nil {
+		t.Fatalf("UpdateNameservers: %v", err)
+	}
+	if got := srv.Nameservers("example.com"); !slices.Equal(got, want) {
+		t.Errorf("nameservers after update = %q, want %q", got, want)
+	}
+	if porkbun.IsPorkbunNameserver(want[0]) {
+		t.Errorf("IsPorkbunNameserver(%q) = true", want[0])
+	}
+
+	if err := client.UpdateNameserversContext(ctx, "example.com", nil); !errors.Is(err, porkbun.ErrInvalidRequest) {
+		t.Errorf("UpdateNameservers with none: err = %v, want ErrInvalidRequest", err)
+	}
+}
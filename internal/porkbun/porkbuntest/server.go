@@ -0,0 +1,864 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package porkbuntest provides an in-process fake of the Porkbun API for
+// tests. It keeps domains and DNS records in memory and speaks the same JSON
+// as api.porkbun.com, so a porkbun.Client, or steamer itself via
+// --api-endpoint, can run against it without network access.
+//
+//	srv := porkbuntest.NewServer()
+//	defer srv.Close()
+//	srv.AddDomain(porkbun.Domain{Domain: "example.com"})
+//	client := srv.Client()
+package porkbuntest
+
+import (
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+)
+
+// Credentials accepted by a Server unless APIKey and SecretAPIKey are changed.
+const (
+	DefaultAPIKey       = "pk1_test"
+	DefaultSecretAPIKey = "sk1_test"
+)
+
+// pageSize is the number of domains domain/listAll returns per request.
+const pageSize = 1000
+
+// Server is a fake Porkbun API backed by in-memory state. It is safe for
+// concurrent use.
+type Server struct {
+	*httptest.Server
+
+	// APIKey and SecretAPIKey are the credentials requests must carry.
+	APIKey       string
+	SecretAPIKey string
+	// ClientIP, if set, is reported by ping instead of the caller's address.
+	ClientIP string
+
+	mu      sync.Mutex
+	domains []porkbun.Domain
+	records map[string][]porkbun.DNSRecord  // domain -> records
+	glue    map[string][]porkbun.GlueRecord // domain -> glue records
+	dnssec  map[string][]porkbun.DSRecord   // domain -> DS records
+	ssl     map[string]porkbun.SSLBundle
+	ns      map[string][]string
+	fwd     map[string][]porkbun.URLForward
+	avail   map[string]porkbun.DomainPricing
+	pricing map[string]porkbun.TLDPricing
+	nextID  int
+
+	failures []Failure // sent, in order, instead of handling requests
+	requests int
+}
+
+// Failure is an error response the server sends instead of handling a
+// request. See FailNext.
+type Failure struct {
+	// Code is the HTTP status code.
+	Code    int
+	Message string
+	// RetryAfter, if set, is sent as the Retry-After header.
+	RetryAfter string
+}
+
+// NewServer starts a fake server with no domains. The caller must call
+// Close when finished.
+func NewServer() *Server {
+	s := &Server{
+		APIKey:       DefaultAPIKey,
+		SecretAPIKey: DefaultSecretAPIKey,
+		records:      make(map[string][]porkbun.DNSRecord),
+		glue:         make(map[string][]porkbun.GlueRecord),
+		dnssec:       make(map[string][]porkbun.DSRecord),
+		ssl:          make(map[string]porkbun.SSLBundle),
+		ns:           make(map[string][]string),
+		fwd:          make(map[string][]porkbun.URLForward),
+		avail:        make(map[string]porkbun.DomainPricing),
+		pricing:      make(map[string]porkbun.TLDPricing),
+		nextID:       100000000,
+	}
+	s.Server = httptest.NewServer(s.handler())
+	return s
+}
+
+// Client returns a porkbun.Client configured with the server's URL and
+// credentials.
+func (s *Server) Client() *porkbun.Client {
+	return porkbun.NewClient(s.APIKey, s.SecretAPIKey,
+		porkbun.WithBaseURL(s.URL),
+		porkbun.WithHTTPClient(s.Server.Client()),
+	)
+}
+
+// AddDomain registers d in the account. Domains are listed in the order
+// they were added.
+func (s *Server) AddDomain(d porkbun.Domain) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	d.Domain = strings.ToLower(d.Domain)
+	if d.Status == "" {
+		d.Status = "ACTIVE"
+	}
+	if d.TLD == "" {
+		_, d.TLD, _ = strings.Cut(d.Domain, ".")
+	}
+	s.domains = append(s.domains, d)
+	if _, ok := s.records[d.Domain]; !ok {
+		s.records[d.Domain] = nil
+		s.ns[d.Domain] = slices.Clone(porkbun.DefaultNameservers)
+	}
+}
+
+// AddRecord adds r to domain, which must have been added with AddDomain,
+// and returns its ID. r.Name may be relative to the domain or fully
+// qualified.
+func (s *Server) AddRecord(domain string, r porkbun.DNSRecord) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	domain = strings.ToLower(domain)
+	return s.addRecord(domain, porkbun.Subdomain(r.Name, domain), r)
+}
+
+// Records returns a copy of the records currently held for domain.
+func (s *Server) Records(domain string) []porkbun.DNSRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.records[strings.ToLower(domain)])
+}
+
+// Glue returns a copy of the glue records currently held for domain.
+func (s *Server) Glue(domain string) []porkbun.GlueRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.glue[strings.ToLower(domain)])
+}
+
+// DSRecords returns a copy of the DS records currently held for domain.
+func (s *Server) DSRecords(domain string) []porkbun.DSRecord {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.dnssec[strings.ToLower(domain)])
+}
+
+// Nameservers returns the nameservers currently registered for domain.
+// Domains start out with porkbun.DefaultNameservers.
+func (s *Server) Nameservers(domain string) []string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.ns[strings.ToLower(domain)])
+}
+
+// URLForwards returns a copy of the URL forwards currently held for domain.
+func (s *Server) URLForwards(domain string) []porkbun.URLForward {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return slices.Clone(s.fwd[strings.ToLower(domain)])
+}
+
+// SetAvailability sets the checkDomain answer for domain. Domains without
+// one are reported as unavailable.
+func (s *Server) SetAvailability(domain string, p porkbun.DomainPricing) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.avail[strings.ToLower(domain)] = p
+}
+
+// SetSSLBundle sets the ssl/retrieve answer for domain. Domains without one
+// get an error, as they do before Porkbun has issued a certificate.
+func (s *Server) SetSSLBundle(domain string, b porkbun.SSLBundle) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.ssl[strings.ToLower(domain)] = b
+}
+
+// SetPricing sets the pricing/get entry for tld.
+func (s *Server) SetPricing(tld string, p porkbun.TLDPricing) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pricing[strings.TrimPrefix(strings.ToLower(tld), ".")] = p
+}
+
+// FailNext makes the next n requests fail with f before they are handled,
+// so nothing they ask for is changed.
+func (s *Server) FailNext(n int, f Failure) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for range n {
+		s.failures = append(s.failures, f)
+	}
+}
+
+// RateLimit makes the next n requests fail the way Porkbun rejects a client
+// that exceeds its rate limit. retryAfter, if set, is sent as the
+// Retry-After header.
+func (s *Server) RateLimit(n int, retryAfter string) {
+	s.FailNext(n, Failure{Code: http.StatusTooManyRequests, Message: "Rate limit exceeded.", RetryAfter: retryAfter})
+}
+
+// Requests returns the number of requests the server has received,
+// including ones that failed.
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+// request holds every field the fake understands across endpoints.
+type request struct {
+	porkbun.BaseRequest
+	porkbun.DSRecord
+	Start   string   `json:"start"`
+	Name    string   `json:"name"`
+	Type    string   `json:"type"`
+	Content string   `json:"content"`
+	TTL     string   `json:"ttl"`
+	Prio    string   `json:"prio"`
+	Notes   *string  `json:"notes"`
+	IPs     []string `json:"ips"`
+	NS      []string `json:"ns"`
+
+	Subdomain   string `json:"subdomain"`
+	Location    string `json:"location"`
+	IncludePath string `json:"includePath"`
+	Wildcard    string `json:"wildcard"`
+}
+
+// handlerFunc handles an authenticated request and returns the response
+// body, which gets "status": "SUCCESS" added.
+type handlerFunc func(r *http.Request, req *request) (map[string]any, error)
+
+// apiError is returned by handlers to produce an ERROR response.
+type apiError struct {
+	code int
+	msg  string
+}
+
+func (e *apiError) Error() string { return e.msg }
+
+func errorf(code int, format string, args ...any) error {
+	return &apiError{code: code, msg: fmt.Sprintf(format, args...)}
+}
+
+func (s *Server) handler() http.Handler {
+	mux := http.NewServeMux()
+	routes := map[string]handlerFunc{
+		"/ping":                                          s.ping,
+		"/domain/listAll":                                s.listDomains,
+		"/domain/checkDomain/{domain}":                   s.checkDomain,
+		"/pricing/get":                                   s.getPricing,
+		"/dns/create/{domain}":                           s.createRecord,
+		"/dns/retrieve/{domain}":                         s.retrieveRecords,
+		"/dns/retrieve/{domain}/{id}":                    s.retrieveRecords,
+		"/dns/edit/{domain}/{id}":                        s.editRecord,
+		"/dns/retrieveByNameType/{domain}/{type}":        s.retrieveByNameType,
+		"/dns/retrieveByNameType/{domain}/{type}/{name}": s.retrieveByNameType,
+		"/dns/editByNameType/{domain}/{type}":            s.editByNameType,
+		"/dns/editByNameType/{domain}/{type}/{name}":     s.editByNameType,
+		"/dns/deleteByNameType/{domain}/{type}":          s.deleteByNameType,
+		"/dns/deleteByNameType/{domain}/{type}/{name}":   s.deleteByNameType,
+		"/dns/delete/{domain}/{id}":                      s.deleteRecord,
+		"/domain/getGlue/{domain}":                       s.getGlue,
+		"/domain/createGlue/{domain}/{host}":             s.createGlue,
+		"/domain/updateGlue/{domain}/{host}":             s.updateGlue,
+		"/domain/deleteGlue/{domain}/{host}":             s.deleteGlue,
+		"/domain/getNs/{domain}":                         s.getNs,
+		"/domain/updateNs/{domain}":                      s.updateNs,
+		"/domain/getUrlForwarding/{domain}":              s.getURLForwarding,
+		"/domain/addUrlForward/{domain}":                 s.addURLForward,
+		"/domain/deleteUrlForward/{domain}/{id}":         s.deleteURLForward,
+		"/ssl/retrieve/{domain}":                         s.retrieveSSL,
+		"/dns/getDnssecRecords/{domain}":                 s.getDnssec,
+		"/dns/createDnssecRecord/{domain}":               s.createDnssec,
+		"/dns/deleteDnssecRecord/{domain}/{keyTag}":      s.deleteDnssec,
+	}
+	for path, h := range routes {
+		mux.Handle("POST "+path, s.wrap(h))
+	}
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeJSON(w, http.StatusNotFound, map[string]any{"status": "ERROR", "message": "Invalid endpoint."})
+	})
+	return mux
+}
+
+// wrap decodes and authenticates the request body, then encodes the
+// handler's result the way Porkbun does.
+func (s *Server) wrap(h handlerFunc) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		s.mu.Lock()
+		s.requests++
+		var fail Failure
+		failing := len(s.failures) > 0
+		if failing {
+			fail, s.failures = s.failures[0], s.failures[1:]
+		}
+		s.mu.Unlock()
+		if failing {
+			if fail.RetryAfter != "" {
+				w.Header().Set("Retry-After", fail.RetryAfter)
+			}
+			writeJSON(w, fail.Code, map[string]any{"status": "ERROR", "message": fail.Message})
+			return
+		}
+
+		var req request
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			writeJSON(w, http.StatusBadRequest, map[string]any{"status": "ERROR", "message": "Invalid JSON body."})
+			return
+		}
+		if req.APIKey != s.APIKey || req.SecretAPIKey != s.SecretAPIKey {
+			writeJSON(w, http.StatusForbidden, map[string]any{"status": "ERROR", "message": "Invalid API key. (002)"})
+			return
+		}
+
+		s.mu.Lock()
+		body, err := h(r, &req)
+		s.mu.Unlock()
+		if err != nil {
+			code := http.StatusBadRequest
+			if e, ok := err.(*apiError); ok {
+				code = e.code
+			}
+			writeJSON(w, code, map[string]any{"status": "ERROR", "message": err.Error()})
+			return
+		}
+		if body == nil {
+			body = map[string]any{}
+		}
+		body["status"] = "SUCCESS"
+		writeJSON(w, http.StatusOK, body)
+	})
+}
+
+func writeJSON(w http.ResponseWriter, code int, v any) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	_ = json.NewEncoder(w).Encode(v)
+}
+
+func (s *Server) ping(r *http.Request, _ *request) (map[string]any, error) {
+	ip := s.ClientIP
+	if ip == "" {
+		ip, _, _ = net.SplitHostPort(r.RemoteAddr)
+	}
+	return map[string]any{"yourIp": ip}, nil
+}
+
+func (s *Server) listDomains(_ *http.Request, req *request) (map[string]any, error) {
+	start := 0
+	if req.Start != "" {
+		n, err := strconv.Atoi(req.Start)
+		if err != nil || n < 0 {
+			return nil, errorf(http.StatusBadRequest, "Invalid start value.")
+		}
+		start = n
+	}
+	page := []map[string]any{}
+	if start < len(s.domains) {
+		for _, d := range s.domains[start:min(start+pageSize, len(s.domains))] {
+			page = append(page, wireDomain(d))
+		}
+	}
+	return map[string]any{"domains": page}, nil
+}
+
+func (s *Server) checkDomain(r *http.Request, _ *request) (map[string]any, error) {
+	domain := strings.ToLower(r.PathValue("domain"))
+	p, ok := s.avail[domain]
+	if !ok {
+		p = porkbun.DomainPricing{Avail: "no"}
+	}
+	return map[string]any{"response": p}, nil
+}
+
+func (s *Server) getPricing(_ *http.Request, _ *request) (map[string]any, error) {
+	return map[string]any{"pricing": s.pricing}, nil
+}
+
+// domain returns the path's domain if it is in the account.
+func (s *Server) domain(r *http.Request) (string, error) {
+	domain := strings.ToLower(r.PathValue("domain"))
+	if _, ok := s.records[domain]; !ok {
+		return "", errorf(http.StatusBadRequest, "Invalid domain.")
+	}
+	return domain, nil
+}
+
+func (s *Server) createRecord(r *http.Request, req *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := porkbun.ValidateContent(req.Type, req.Content); err != nil {
+		return nil, errorf(http.StatusBadRequest, "Invalid record: %v", err)
+	}
+	rec := porkbun.DNSRecord{
+		Type:    strings.ToUpper(req.Type),
+		Content: req.Content,
+	}
+	if err := setTTLPrio(&rec, req); err != nil {
+		return nil, err
+	}
+	if req.Notes != nil {
+		rec.Notes = *req.Notes
+	}
+	id := s.addRecord(domain, req.Name, rec)
+	n, _ := strconv.Atoi(id)
+	return map[string]any{"id": n}, nil
+}
+
+func (s *Server) retrieveRecords(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	records := []map[string]any{}
+	id := r.PathValue("id")
+	for _, rec := range s.records[domain] {
+		if id == "" || rec.ID == id {
+			records = append(records, wireRecord(rec))
+		}
+	}
+	return map[string]any{"records": records}, nil
+}
+
+func (s *Server) editRecord(r *http.Request, req *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	i := s.recordIndex(domain, r.PathValue("id"))
+	if i < 0 {
+		return nil, errorf(http.StatusBadRequest, "Invalid record ID.")
+	}
+	if err := porkbun.ValidateContent(req.Type, req.Content); err != nil {
+		return nil, errorf(http.StatusBadRequest, "Invalid record: %v", err)
+	}
+	rec := &s.records[domain][i]
+	if err := setTTLPrio(rec, req); err != nil {
+		return nil, err
+	}
+	rec.Name = fqdn(req.Name, domain)
+	rec.Type = strings.ToUpper(req.Type)
+	rec.Content = req.Content
+	if req.Notes != nil {
+		rec.Notes = *req.Notes
+	}
+	return nil, nil
+}
+
+func (s *Server) deleteRecord(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	i := s.recordIndex(domain, r.PathValue("id"))
+	if i < 0 {
+		return nil, errorf(http.StatusBadRequest, "Invalid record ID.")
+	}
+	s.records[domain] = slices.Delete(s.records[domain], i, i+1)
+	return nil, nil
+}
+
+func (s *Server) getGlue(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	// Porkbun returns each host as a [host, {"v4": [...], "v6": [...]}] pair.
+	hosts := []any{}
+	for _, g := range s.glue[domain] {
+		hosts = append(hosts, []any{g.Host, map[string][]string{"v4": g.IPv4, "v6": g.IPv6}})
+	}
+	return map[string]any{"hosts": hosts}, nil
+}
+
+func (s *Server) createGlue(r *http.Request, req *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	host := fqdn(r.PathValue("host"), domain)
+	if s.glueIndex(domain, host) >= 0 {
+		return nil, errorf(http.StatusBadRequest, "Glue record already exists for %s.", host)
+	}
+	g, err := glueRecord(host, req.IPs)
+	if err != nil {
+		return nil, err
+	}
+	s.glue[domain] = append(s.glue[domain], g)
+	return nil, nil
+}
+
+func (s *Server) updateGlue(r *http.Request, req *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	host := fqdn(r.PathValue("host"), domain)
+	i := s.glueIndex(domain, host)
+	if i < 0 {
+		return nil, errorf(http.StatusBadRequest, "Glue record not found for %s.", host)
+	}
+	g, err := glueRecord(host, req.IPs)
+	if err != nil {
+		return nil, err
+	}
+	s.glue[domain][i] = g
+	return nil, nil
+}
+
+func (s *Server) deleteGlue(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	host := fqdn(r.PathValue("host"), domain)
+	i := s.glueIndex(domain, host)
+	if i < 0 {
+		return nil, errorf(http.StatusBadRequest, "Glue record not found for %s.", host)
+	}
+	s.glue[domain] = slices.Delete(s.glue[domain], i, i+1)
+	return nil, nil
+}
+
+func (s *Server) glueIndex(domain, host string) int {
+	return slices.IndexFunc(s.glue[domain], func(g porkbun.GlueRecord) bool {
+		return g.Host == host
+	})
+}
+
+// glueRecord splits ips into IPv4 and IPv6 addresses.
+func glueRecord(host string, ips []string) (porkbun.GlueRecord, error) {
+	g := porkbun.GlueRecord{Host: host, IPv4: []string{}, IPv6: []string{}}
+	if len(ips) == 0 {
+		return g, errorf(http.StatusBadRequest, "At least one IP address is required.")
+	}
+	for _, ip := range ips {
+		addr, err := netip.ParseAddr(ip)
+		if err != nil {
+			return g, errorf(http.StatusBadRequest, "Invalid IP address %s.", ip)
+		}
+		if addr.Is4() {
+			g.IPv4 = append(g.IPv4, addr.String())
+		} else {
+			g.IPv6 = append(g.IPv6, addr.String())
+		}
+	}
+	return g, nil
+}
+
+func (s *Server) getDnssec(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	// Porkbun returns an object keyed by key tag, or an empty array.
+	if len(s.dnssec[domain]) == 0 {
+		return map[string]any{"records": []any{}}, nil
+	}
+	records := make(map[string]porkbun.DSRecord)
+	for _, ds := range s.dnssec[domain] {
+		records[ds.KeyTag] = ds
+	}
+	return map[string]any{"records": records}, nil
+}
+
+func (s *Server) createDnssec(r *http.Request, req *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := porkbun.ValidateDSRecord(req.DSRecord); err != nil {
+		return nil, errorf(http.StatusBadRequest, "Invalid DS record: %v", err)
+	}
+	if s.dnssecIndex(domain, req.KeyTag) >= 0 {
+		return nil, errorf(http.StatusBadRequest, "A DS record with key tag %s already exists.", req.KeyTag)
+	}
+	s.dnssec[domain] = append(s.dnssec[domain], req.DSRecord)
+	return nil, nil
+}
+
+func (s *Server) deleteDnssec(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	i := s.dnssecIndex(domain, r.PathValue("keyTag"))
+	if i < 0 {
+		return nil, errorf(http.StatusBadRequest, "DS record not found.")
+	}
+	s.dnssec[domain] = slices.Delete(s.dnssec[domain], i, i+1)
+	return nil, nil
+}
+
+func (s *Server) dnssecIndex(domain, keyTag string) int {
+	return slices.IndexFunc(s.dnssec[domain], func(ds porkbun.DSRecord) bool {
+		return ds.KeyTag == keyTag
+	})
+}
+
+func (s *Server) getNs(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]any{"ns": s.ns[domain]}, nil
+}
+
+func (s *Server) updateNs(r *http.Request, req *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	if err := porkbun.ValidateNameservers(req.NS); err != nil {
+		return nil, errorf(http.StatusBadRequest, "Invalid nameservers: %v", err)
+	}
+	s.ns[domain] = slices.Clone(req.NS)
+	return nil, nil
+}
+
+func (s *Server) getURLForwarding(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	forwards := slices.Clone(s.fwd[domain])
+	if forwards == nil {
+		forwards = []porkbun.URLForward{}
+	}
+	return map[string]any{"forwards": forwards}, nil
+}
+
+func (s *Server) addURLForward(r *http.Request, req *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	f := porkbun.URLForward{
+		Subdomain:   req.Subdomain,
+		Location:    req.Location,
+		Type:        req.Type,
+		IncludePath: req.IncludePath,
+		Wildcard:    req.Wildcard,
+	}
+	if err := porkbun.ValidateURLForward(f); err != nil {
+		return nil, errorf(http.StatusBadRequest, "Invalid URL forward: %v", err)
+	}
+	s.nextID++
+	f.ID = strconv.Itoa(s.nextID)
+	s.fwd[domain] = append(s.fwd[domain], f)
+	return nil, nil
+}
+
+func (s *Server) deleteURLForward(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	id := r.PathValue("id")
+	i := slices.IndexFunc(s.fwd[domain], func(f porkbun.URLForward) bool { return f.ID == id })
+	if i < 0 {
+		return nil, errorf(http.StatusBadRequest, "URL forward not found.")
+	}
+	s.fwd[domain] = slices.Delete(s.fwd[domain], i, i+1)
+	return nil, nil
+}
+
+func (s *Server) retrieveSSL(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	b, ok := s.ssl[domain]
+	if !ok {
+		return nil, errorf(http.StatusBadRequest, "The SSL certificate is not ready for this domain.")
+	}
+	return map[string]any{
+		"certificatechain": b.CertificateChain,
+		"privatekey":       b.PrivateKey,
+		"publickey":        b.PublicKey,
+	}, nil
+}
+
+func (s *Server) retrieveByNameType(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	records := []map[string]any{}
+	for _, i := range s.nameTypeIndexes(r, domain) {
+		records = append(records, wireRecord(s.records[domain][i]))
+	}
+	return map[string]any{"records": records}, nil
+}
+
+func (s *Server) editByNameType(r *http.Request, req *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	typ := strings.ToUpper(r.PathValue("type"))
+	if err := porkbun.ValidateContent(typ, req.Content); err != nil {
+		return nil, errorf(http.StatusBadRequest, "Invalid record: %v", err)
+	}
+	for _, i := range s.nameTypeIndexes(r, domain) {
+		rec := &s.records[domain][i]
+		if err := setTTLPrio(rec, req); err != nil {
+			return nil, err
+		}
+		rec.Content = req.Content
+		if req.Notes != nil {
+			rec.Notes = *req.Notes
+		}
+	}
+	return nil, nil
+}
+
+func (s *Server) deleteByNameType(r *http.Request, _ *request) (map[string]any, error) {
+	domain, err := s.domain(r)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, i := range s.nameTypeIndexes(r, domain) {
+		ids = append(ids, s.records[domain][i].ID)
+	}
+	s.records[domain] = slices.DeleteFunc(s.records[domain], func(rec porkbun.DNSRecord) bool {
+		return slices.Contains(ids, rec.ID)
+	})
+	return nil, nil
+}
+
+// nameTypeIndexes returns the indexes of the records matching the type and
+// optional name in the path.
+func (s *Server) nameTypeIndexes(r *http.Request, domain string) []int {
+	name := fqdn(r.PathValue("name"), domain)
+	typ := strings.ToUpper(r.PathValue("type"))
+	var indexes []int
+	for i, rec := range s.records[domain] {
+		if rec.Type == typ && rec.Name == name {
+			indexes = append(indexes, i)
+		}
+	}
+	return indexes
+}
+
+// addRecord stores rec under name, relative to domain, filling in defaults
+// the way Porkbun does. s.mu must be held.
+func (s *Server) addRecord(domain, name string, rec porkbun.DNSRecord) string {
+	s.nextID++
+	rec.ID = strconv.Itoa(s.nextID)
+	rec.Name = fqdn(name, domain)
+	rec.Type = strings.ToUpper(rec.Type)
+	if rec.TTL == 0 {
+		rec.TTL = porkbun.MinTTL
+	}
+	s.records[domain] = append(s.records[domain], rec)
+	return rec.ID
+}
+
+// setTTLPrio copies the TTL and priority of req to rec. Like Porkbun, it
+// falls back to the minimum TTL and no priority when they are omitted.
+func setTTLPrio(rec *porkbun.DNSRecord, req *request) error {
+	rec.TTL, rec.Prio = porkbun.MinTTL, 0
+	if req.TTL != "" {
+		ttl, err := strconv.Atoi(req.TTL)
+		if err != nil {
+			return errorf(http.StatusBadRequest, "Invalid TTL.")
+		}
+		rec.TTL = ttl
+	}
+	if req.Prio != "" {
+		prio, err := strconv.Atoi(req.Prio)
+		if err != nil {
+			return errorf(http.StatusBadRequest, "Invalid priority.")
+		}
+		rec.Prio = prio
+	}
+	return nil
+}
+
+// wireRecord renders rec the way Porkbun does, with TTL and priority as
+// strings.
+func wireRecord(rec porkbun.DNSRecord) map[string]any {
+	return map[string]any{
+		"id":      rec.ID,
+		"name":    rec.Name,
+		"type":    rec.Type,
+		"content": rec.Content,
+		"ttl":     strconv.Itoa(rec.TTL),
+		"prio":    strconv.Itoa(rec.Prio),
+		"notes":   rec.Notes,
+	}
+}
+
+// wireDomain renders d the way domain/listAll does, with dates as
+// "2006-01-02 15:04:05", the lock and privacy flags as "1"/"0" strings and
+// the others as numbers.
+func wireDomain(d porkbun.Domain) map[string]any {
+	date := func(t time.Time) string {
+		if t.IsZero() {
+			return "0000-00-00 00:00:00"
+		}
+		return t.UTC().Format("2006-01-02 15:04:05")
+	}
+	flag := func(b bool) int {
+		if b {
+			return 1
+		}
+		return 0
+	}
+	labels := d.Labels
+	if labels == nil {
+		labels = []porkbun.Label{}
+	}
+	return map[string]any{
+		"domain":       d.Domain,
+		"status":       d.Status,
+		"tld":          d.TLD,
+		"createDate":   date(d.CreateDate),
+		"expireDate":   date(d.ExpireDate),
+		"securityLock": strconv.Itoa(flag(d.SecurityLock)),
+		"whoisPrivacy": strconv.Itoa(flag(d.WhoisPrivacy)),
+		"autoRenew":    flag(d.AutoRenew),
+		"notLocal":     flag(d.NotLocal),
+		"labels":       labels,
+	}
+}
+
+func (s *Server) recordIndex(domain, id string) int {
+	return slices.IndexFunc(s.records[domain], func(r porkbun.DNSRecord) bool {
+		return r.ID == id
+	})
+}
+
+func fqdn(name, domain string) string {
+	if name == "" || name == "@" {
+		return domain
+	}
+	return strings.ToLower(name) + "." + domain
+}
@@ -0,0 +1,146 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"errors"
+	"math/rand/v2"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// RetryPolicy controls how the client retries failed requests.
+//
+// Read-only endpoints (ping, list, get, retrieve, check and pricing calls)
+// are retried after network errors, 5xx responses and rate limiting.
+// Endpoints that change state are only retried when the request is known not
+// to have been applied: the connection could not be established, or Porkbun
+// rejected it for exceeding a rate limit.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the first attempt.
+	MaxRetries int
+	// BaseDelay is the backoff before the first retry. It doubles with
+	// each retry, up to MaxDelay, and is jittered to spread out clients.
+	BaseDelay time.Duration
+	MaxDelay  time.Duration
+	// RateLimitDelay is how long to wait after a rate-limit response that
+	// doesn't carry a Retry-After header. A Retry-After longer than
+	// MaxDelay is cut to MaxDelay, so a bad header can't stall the client.
+	RateLimitDelay time.Duration
+}
+
+// DefaultRetryPolicy is the policy used by clients created with NewClient.
+var DefaultRetryPolicy = RetryPolicy{
+	MaxRetries:     3,
+	BaseDelay:      500 * time.Millisecond,
+	MaxDelay:       8 * time.Second,
+	RateLimitDelay: 10 * time.Second,
+}
+
+// delay reports whether a request to endpoint that failed with err on the
+// given attempt (0 for the first) should be retried, and how long to wait.
+func (p RetryPolicy) delay(endpoint string, err error, attempt int) (time.Duration, bool) {
+	if attempt >= p.MaxRetries {
+		return 0, false
+	}
+
+	var apiErr *APIError
+	if errors.As(err, &apiErr) && errors.Is(apiErr, ErrRateLimited) {
+		if wait := apiErr.retryAfter; wait > 0 {
+			if p.MaxDelay > 0 && wait > p.MaxDelay {
+				wait = p.MaxDelay
+			}
+			return wait, true
+		}
+		return p.RateLimitDelay, true
+	}
+
+	switch {
+	case isDialError(err):
+		// The request never reached Porkbun, so even writes are safe.
+	case !isReadOnly(endpoint):
+		return 0, false
+	case apiErr != nil && apiErr.StatusCode < http.StatusInternalServerError:
+		return 0, false
+	}
+	return p.backoff(attempt), true
+}
+
+// backoff returns an exponentially growing delay with equal jitter: half of
+// the delay is fixed and the other half random.
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.BaseDelay << attempt
+	if d <= 0 || (p.MaxDelay > 0 && d > p.MaxDelay) {
+		d = p.MaxDelay
+	}
+	if d <= 0 {
+		return 0
+	}
+	half := d / 2
+	return half + rand.N(half+1)
+}
+
+// isReadOnly reports whether endpoint only reads state. Porkbun names these
+// consistently, e.g. domain/listAll, dns/retrieve/DOMAIN and pricing/get.
+func isReadOnly(endpoint string) bool {
+	if endpoint == "ping" {
+		return true
+	}
+	parts := strings.Split(endpoint, "/")
+	if len(parts) < 2 {
+		return false
+	}
+	action := parts[1]
+	for _, prefix := range []string{"retrieve", "get", "list", "check"} {
+		if strings.HasPrefix(action, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isDialError reports whether err happened while connecting, before any
+// part of the request was sent.
+func isDialError(err error) bool {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && opErr.Op == "dial" {
+		return true
+	}
+	var dnsErr *net.DNSError
+	return errors.As(err, &dnsErr)
+}
+
+func isRateLimitMessage(msg string) bool {
+	msg = strings.ToLower(msg)
+	return strings.Contains(msg, "rate limit") || strings.Contains(msg, "too many requests")
+}
+
+// parseRetryAfter understands both forms of the Retry-After header: a number
+// of seconds or an HTTP date.
+func parseRetryAfter(v string) time.Duration {
+	if v == "" {
+		return 0
+	}
+	if secs, err := strconv.Atoi(v); err == nil && secs > 0 {
+		return time.Duration(secs) * time.Second
+	}
+	if t, err := http.ParseTime(v); err == nil {
+		return time.Until(t)
+	}
+	return 0
+}
@@ -0,0 +1,127 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"errors"
+	"io"
+	"net"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestBackoff(t *testing.T) {
+	p := RetryPolicy{BaseDelay: 100 * time.Millisecond, MaxDelay: time.Second}
+	for attempt, want := range []time.Duration{100, 200, 400, 800, 1000, 1000} {
+		want *= time.Millisecond
+		for range 20 {
+			if d := p.backoff(attempt); d < want/2 || d > want {
+				t.Errorf("backoff(%d) = %s, want between %s and %s", attempt, d, want/2, want)
+			}
+		}
+	}
+	// Shifting past the width of a Duration must not wrap around.
+	if d := p.backoff(70); d < p.MaxDelay/2 || d > p.MaxDelay {
+		t.Errorf("backoff(70) = %s, want at most %s", d, p.MaxDelay)
+	}
+	if d := (RetryPolicy{}).backoff(0); d != 0 {
+		t.Errorf("zero policy backoff = %s, want 0", d)
+	}
+}
+
+func TestIsReadOnly(t *testing.T) {
+	tests := map[string]bool{
+		"ping":                     true,
+		"domain/listAll":           true,
+		"dns/retrieve/example.com": true,
+		"dns/retrieveByNameType/example.com/A/www": true,
+		"domain/getNs/example.com":                 true,
+		"domain/checkDomain/example.com":           true,
+		"pricing/get":                              true,
+		"dns/create/example.com":                   false,
+		"dns/edit/example.com/1":                   false,
+		"dns/deleteByNameType/example.com/A":       false,
+		"domain/updateNs/example.com":              false,
+		"domain/addUrlForward/example.com":         false,
+		"ssl":                                      false,
+	}
+	for endpoint, want := range tests {
+		if got := isReadOnly(endpoint); got != want {
+			t.Errorf("isReadOnly(%q) = %v, want %v", endpoint, got, want)
+		}
+	}
+}
+
+func TestParseRetryAfter(t *testing.T) {
+	tests := map[string]time.Duration{
+		"":     0,
+		"5":    5 * time.Second,
+		"0":    0,
+		"-3":   0,
+		"soon": 0,
+	}
+	for v, want := range tests {
+		if got := parseRetryAfter(v); got != want {
+			t.Errorf("parseRetryAfter(%q) = %s, want %s", v, got, want)
+		}
+	}
+	date := time.Now().Add(time.Minute).UTC().Format(http.TimeFormat)
+	if got := parseRetryAfter(date); got < 55*time.Second || got > time.Minute {
+		t.Errorf("parseRetryAfter(%q) = %s, want about a minute", date, got)
+	}
+	past := time.Now().Add(-time.Minute).UTC().Format(http.TimeFormat)
+	if got := parseRetryAfter(past); got > 0 {
+		t.Errorf("parseRetryAfter(%q) = %s, want no wait", past, got)
+	}
+}
+
+func TestDelay(t *testing.T) {
+	p := RetryPolicy{MaxRetries: 3, BaseDelay: time.Second, MaxDelay: 8 * time.Second, RateLimitDelay: 10 * time.Second}
+	rateLimited := func(retryAfter time.Duration) error {
+		return &APIError{StatusCode: http.StatusTooManyRequests, Message: "Rate limit exceeded.", retryAfter: retryAfter}
+	}
+	serverError := &APIError{StatusCode: http.StatusInternalServerError, Message: "Internal error."}
+	badRequest := &APIError{StatusCode: http.StatusBadRequest, Message: "Invalid type."}
+	dialError := &net.OpError{Op: "dial", Net: "tcp", Err: errors.New("connection refused")}
+
+	tests := []struct {
+		name     string
+		endpoint string
+		err      error
+		attempt  int
+		retry    bool
+		min, max time.Duration
+	}{
+		{"rate limited write", "dns/create/example.com", rateLimited(0), 0, true, 10 * time.Second, 10 * time.Second},
+		{"Retry-After", "dns/create/example.com", rateLimited(3 * time.Second), 0, true, 3 * time.Second, 3 * time.Second},
+		{"Retry-After capped", "ping", rateLimited(6 * time.Hour), 0, true, 8 * time.Second, 8 * time.Second},
+		{"read after 5xx", "dns/retrieve/example.com", serverError, 1, true, time.Second, 2 * time.Second},
+		{"write after 5xx", "dns/create/example.com", serverError, 0, false, 0, 0},
+		{"read after 4xx", "dns/retrieve/example.com", badRequest, 0, false, 0, 0},
+		{"write after dial error", "dns/create/example.com", dialError, 0, true, 500 * time.Millisecond, time.Second},
+		{"write after lost response", "dns/create/example.com", io.ErrUnexpectedEOF, 0, false, 0, 0},
+		{"read after lost response", "domain/listAll", io.ErrUnexpectedEOF, 0, true, 500 * time.Millisecond, time.Second},
+		{"out of retries", "ping", rateLimited(0), 3, false, 0, 0},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			d, retry := p.delay(tt.endpoint, tt.err, tt.attempt)
+			if retry != tt.retry || d < tt.min || d > tt.max {
+				t.Errorf("delay = %s, %v; want %v after between %s and %s", d, retry, tt.retry, tt.min, tt.max)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/ghchinoy/steamer/internal/porkbun"
+	"github.com/ghchinoy/steamer/internal/porkbun/porkbuntest"
+)
+
+// fastRetries retries quickly so tests don't wait on real backoff.
+var fastRetries = porkbun.RetryPolicy{
+	MaxRetries:     3,
+	BaseDelay:      time.Millisecond,
+	MaxDelay:       20 * time.Millisecond,
+	RateLimitDelay: time.Millisecond,
+}
+
+func TestRetryRateLimited(t *testing.T) {
+	srv, client := newServer(t)
+	client.Retry = fastRetries
+	srv.RateLimit(2, "")
+
+	// A rate-limited request was never applied, so even writes are retried.
+	if _, err := client.CreateRecordContext(context.Background(), "example.com", porkbun.CreateRecordRequest{Type: "A", Content: "192.0.2.1"}); err != nil {
+		t.Fatalf("CreateRecord: %v", err)
+	}
+	if n := srv.Requests(); n != 3 {
+		t.Errorf("server saw %d requests, want 3", n)
+	}
+	if records := srv.Records("example.com"); len(records) != 1 {
+		t.Errorf("records = %+v, want exactly one", records)
+	}
+}
+
+func TestRetryAfterCapped(t *testing.T) {
+	srv, client := newServer(t)
+	client.Retry = fastRetries
+	srv.RateLimit(1, "3600")
+
+	start := time.Now()
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	if _, err := client.PingContext(ctx); err != nil {
+		t.Fatalf("Ping: %v", err)
+	}
+	if elapsed := time.Since(start); elapsed < fastRetries.MaxDelay {
+		t.Errorf("retried after %s, want to wait MaxDelay (%s)", elapsed, fastRetries.MaxDelay)
+	}
+}
+
+func TestRetryGivesUp(t *testing.T) {
+	srv, client := newServer(t)
+	client.Retry = fastRetries
+	srv.RateLimit(10, "")
+
+	_, err := client.PingContext(context.Background())
+	if !errors.Is(err, porkbun.ErrRateLimited) {
+		t.Fatalf("Ping error = %v, want ErrRateLimited", err)
+	}
+	if n := srv.Requests(); n != fastRetries.MaxRetries+1 {
+		t.Errorf("server saw %d requests, want %d", n, fastRetries.MaxRetries+1)
+	}
+}
+
+func TestRetryServerErrors(t *testing.T) {
+	srv, client := newServer(t)
+	client.Retry = fastRetries
+	ctx := context.Background()
+	failure := porkbuntest.Failure{Code: http.StatusBadGateway, Message: "Bad gateway."}
+
+	srv.FailNext(2, failure)
+	if _, err := client.RetrieveRecordsContext(ctx, "example.com"); err != nil {
+		t.Fatalf("RetrieveRecords: %v", err)
+	}
+	if n := srv.Requests(); n != 3 {
+		t.Errorf("read: server saw %d requests, want 3", n)
+	}
+
+	// Porkbun may have applied a write that failed like this, so it must
+	// not be sent again.
+	srv.FailNext(1, failure)
+	_, err := client.CreateRecordContext(ctx, "example.com", porkbun.CreateRecordRequest{Type: "A", Content: "192.0.2.1"})
+	if err == nil {
+		t.Fatal("CreateRecord succeeded, want the 502")
+	}
+	if n := srv.Requests(); n != 4 {
+		t.Errorf("write: server saw %d requests in total, want 4", n)
+	}
+}
@@ -0,0 +1,55 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"context"
+	"fmt"
+)
+
+// SSLBundle is the free certificate Porkbun issues for a domain, in PEM
+// format.
+type SSLBundle struct {
+	CertificateChain string `json:"certificatechain"`
+	PrivateKey       string `json:"privatekey"`
+	PublicKey        string `json:"publickey"`
+}
+
+// SSLBundleResponse is the response from the ssl/retrieve endpoint.
+type SSLBundleResponse struct {
+	APIResponse
+	SSLBundle
+}
+
+// RetrieveSSLBundle fetches the certificate chain and keys for a domain.
+func (c *Client) RetrieveSSLBundle(domain string) (*SSLBundle, error) {
+	return c.RetrieveSSLBundleContext(context.Background(), domain)
+}
+
+// RetrieveSSLBundleContext is like RetrieveSSLBundle but uses ctx for the
+// request.
+func (c *Client) RetrieveSSLBundleContext(ctx context.Context, domain string) (*SSLBundle, error) {
+	req := BaseRequest{
+		APIKey:       c.APIKey,
+		SecretAPIKey: c.SecretAPIKey,
+	}
+	var res SSLBundleResponse
+	endpoint := fmt.Sprintf("ssl/retrieve/%s", domain)
+	err := c.post(ctx, endpoint, req, &res)
+	if err != nil {
+		return nil, err
+	}
+	return &res.SSLBundle, nil
+}
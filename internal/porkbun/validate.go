@@ -0,0 +1,212 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package porkbun
+
+import (
+	"encoding/hex"
+	"fmt"
+	"net/netip"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// RecordTypes lists the DNS record types Porkbun accepts.
+var RecordTypes = []string{"A", "AAAA", "ALIAS", "CAA", "CNAME", "HTTPS", "MX", "NS", "SRV", "SSHFP", "SVCB", "TLSA", "TXT"}
+
+// MinTTL is the lowest TTL, in seconds, Porkbun accepts for a record. It is
+// also the TTL records get when none is given.
+const MinTTL = 600
+
+// ValidateTTL checks that ttl is at least MinTTL. Errors match
+// ErrInvalidRequest.
+func ValidateTTL(ttl int) error {
+	if ttl < MinTTL {
+		return &validationError{err: fmt.Errorf("ttl %d is below the minimum of %d seconds", ttl, MinTTL)}
+	}
+	return nil
+}
+
+// ValidateContent checks that content is well formed for a record of type
+// typ, using the same layout Porkbun expects: MX and SRV priorities are
+// passed separately, so MX content is just the mail server and SRV content
+// is "weight port target". Errors match ErrInvalidRequest.
+func ValidateContent(typ, content string) error {
+	if err := validateContent(typ, content); err != nil {
+		return &validationError{err: err}
+	}
+	return nil
+}
+
+func validateContent(typ, content string) error {
+	typ = strings.ToUpper(typ)
+	if !slices.Contains(RecordTypes, typ) {
+		return fmt.Errorf("unsupported record type %q (supported: %s)", typ, strings.Join(RecordTypes, ", "))
+	}
+	if strings.TrimSpace(content) == "" {
+		return fmt.Errorf("%s record content must not be empty", typ)
+	}
+	fields := strings.Fields(content)
+
+	switch typ {
+	case "A", "AAAA":
+		addr, err := netip.ParseAddr(content)
+		if err != nil {
+			return fmt.Errorf("%s record content %q is not an IP address", typ, content)
+		}
+		if typ == "A" && !addr.Is4() {
+			return fmt.Errorf("A record content %q is not an IPv4 address (use AAAA for IPv6)", content)
+		}
+		if typ == "AAAA" && !addr.Is6() {
+			return fmt.Errorf("AAAA record content %q is not an IPv6 address (use A for IPv4)", content)
+		}
+	case "CNAME", "ALIAS", "NS", "MX":
+		// A null MX (RFC 7505) points at the root to say the domain
+		// accepts no mail.
+		if typ == "MX" && content == "." {
+			break
+		}
+		if _, err := netip.ParseAddr(content); err == nil {
+			return fmt.Errorf("%s record content must be a hostname, not an IP address", typ)
+		}
+		if err := validateHostname(content); err != nil {
+			return fmt.Errorf("%s record content: %w", typ, err)
+		}
+	case "SRV":
+		if len(fields) != 3 {
+			return fmt.Errorf(`SRV record content must be "weight port target", got %d fields`, len(fields))
+		}
+		if err := validateUint(fields[0], "weight", 65535); err != nil {
+			return fmt.Errorf("SRV record content: %w", err)
+		}
+		if err := validateUint(fields[1], "port", 65535); err != nil {
+			return fmt.Errorf("SRV record content: %w", err)
+		}
+		if fields[2] != "." {
+			if err := validateHostname(fields[2]); err != nil {
+				return fmt.Errorf("SRV record target: %w", err)
+			}
+		}
+	case "CAA":
+		if len(fields) < 3 {
+			return fmt.Errorf(`CAA record content must be "flags tag value", got %d fields`, len(fields))
+		}
+		if err := validateUint(fields[0], "flags", 255); err != nil {
+			return fmt.Errorf("CAA record content: %w", err)
+		}
+		tag := fields[1]
+		for _, r := range tag {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9') {
+				return fmt.Errorf("CAA record tag %q must be alphanumeric (e.g. issue, issuewild, iodef)", tag)
+			}
+		}
+		if value := strings.Trim(strings.Join(fields[2:], " "), `"`); value == "" && tag != "issue" && tag != "issuewild" {
+			return fmt.Errorf("CAA record value must not be empty")
+		}
+	case "TLSA":
+		if len(fields) != 4 {
+			return fmt.Errorf(`TLSA record content must be "usage selector matching-type data", got %d fields`, len(fields))
+		}
+		if err := validateUint(fields[0], "usage", 3); err != nil {
+			return fmt.Errorf("TLSA record content: %w", err)
+		}
+		if err := validateUint(fields[1], "selector", 1); err != nil {
+			return fmt.Errorf("TLSA record content: %w", err)
+		}
+		if err := validateUint(fields[2], "matching type", 2); err != nil {
+			return fmt.Errorf("TLSA record content: %w", err)
+		}
+		want := map[string]int{"1": 32, "2": 64}[fields[2]]
+		if err := validateHex(fields[3], "certificate data", want); err != nil {
+			return fmt.Errorf("TLSA record content: %w", err)
+		}
+	case "SSHFP":
+		if len(fields) != 3 {
+			return fmt.Errorf(`SSHFP record content must be "algorithm type fingerprint", got %d fields`, len(fields))
+		}
+		if err := validateUint(fields[0], "algorithm", 255); err != nil {
+			return fmt.Errorf("SSHFP record content: %w", err)
+		}
+		if err := validateUint(fields[1], "fingerprint type", 255); err != nil {
+			return fmt.Errorf("SSHFP record content: %w", err)
+		}
+		want := map[string]int{"1": 20, "2": 32}[fields[1]]
+		if err := validateHex(fields[2], "fingerprint", want); err != nil {
+			return fmt.Errorf("SSHFP record content: %w", err)
+		}
+	case "HTTPS", "SVCB":
+		if len(fields) < 2 {
+			return fmt.Errorf(`%s record content must be "priority target [params...]", got %d fields`, typ, len(fields))
+		}
+		if err := validateUint(fields[0], "priority", 65535); err != nil {
+			return fmt.Errorf("%s record content: %w", typ, err)
+		}
+		if fields[1] != "." {
+			if err := validateHostname(fields[1]); err != nil {
+				return fmt.Errorf("%s record target: %w", typ, err)
+			}
+		}
+		for _, p := range fields[2:] {
+			if key, _, _ := strings.Cut(p, "="); key == "" {
+				return fmt.Errorf("%s record parameter %q must be key=value", typ, p)
+			}
+		}
+	}
+	return nil
+}
+
+// validateHostname checks the length and characters of each label. An
+// underscore is allowed since service names like _sip._tcp use it.
+func validateHostname(name string) error {
+	name = strings.TrimSuffix(name, ".")
+	if name == "" || len(name) > 253 {
+		return fmt.Errorf("%q is not a valid hostname", name)
+	}
+	for _, label := range strings.Split(name, ".") {
+		if label == "" || len(label) > 63 {
+			return fmt.Errorf("%q is not a valid hostname", name)
+		}
+		if label[0] == '-' || label[len(label)-1] == '-' {
+			return fmt.Errorf("%q is not a valid hostname: labels cannot start or end with '-'", name)
+		}
+		for _, r := range label {
+			if !(r >= 'a' && r <= 'z' || r >= 'A' && r <= 'Z' || r >= '0' && r <= '9' || r == '-' || r == '_') {
+				return fmt.Errorf("%q is not a valid hostname: invalid character %q", name, r)
+			}
+		}
+	}
+	return nil
+}
+
+func validateUint(s, field string, max uint64) error {
+	v, err := strconv.ParseUint(s, 10, 64)
+	if err != nil || v > max {
+		return fmt.Errorf("%s %q must be a number between 0 and %d", field, s, max)
+	}
+	return nil
+}
+
+// validateHex checks s is hex encoded and, if wantBytes is non-zero, that it
+// decodes to exactly that many bytes.
+func validateHex(s, field string, wantBytes int) error {
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return fmt.Errorf("%s must be hexadecimal", field)
+	}
+	if wantBytes > 0 && len(b) != wantBytes {
+		return fmt.Errorf("%s must be %d hex characters, got %d", field, wantBytes*2, len(s))
+	}
+	return nil
+}
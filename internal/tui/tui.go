@@ -16,6 +16,7 @@
 package tui
 
 import (
+	"context"
 	"fmt"
 
 	"github.com/ghchinoy/steamer/internal/porkbun"
@@ -45,6 +46,8 @@ const (
 
 // Model is the Bubble Tea model for the steamer TUI.
 type Model struct {
+	ctx      context.Context
+	cancel   context.CancelFunc
 	client   *porkbun.Client
 	state    state
 	domains  []porkbun.Domain
@@ -56,9 +59,13 @@ type Model struct {
 	domain   string // currently viewed domain
 }
 
-// NewModel creates a new TUI model.
-func NewModel(client *porkbun.Client, initialDomain string) Model {
+// NewModel creates a new TUI model. API requests are made with a context
+// derived from ctx, which is cancelled when the user quits.
+func NewModel(ctx context.Context, client *porkbun.Client, initialDomain string) Model {
+	ctx, cancel := context.WithCancel(ctx)
 	return Model{
+		ctx:     ctx,
+		cancel:  cancel,
 		client:  client,
 		state:   viewDomains,
 		cursor:  0,
@@ -81,6 +88,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.KeyMsg:
 		switch msg.String() {
 		case "ctrl+c", "q":
+			m.cancel()
 			return m, tea.Quit
 		case "up", "k":
 			if m.cursor > 0 {
@@ -152,7 +160,7 @@ func (m Model) View() string {
 		s += fmt.Sprintf("DNS Records for %s:\n\n", m.domain)
 		s += fmt.Sprintf("%-10s %-25s %-10s %-30s\n", "ID", "NAME", "TYPE", "CONTENT")
 		for i, r := range m.records {
-			line := fmt.Sprintf("%-10v %-25s %-10s %-30s", r.ID, r.Name, r.Type, r.Content)
+			line := fmt.Sprintf("%-10s %-25s %-10s %-30s", r.ID, r.Name, r.Type, r.Content)
 			if m.cursor == i {
 				s += selectedStyle.Render("> "+line) + "\n"
 			} else {
@@ -171,7 +179,7 @@ type recordsMsg []porkbun.DNSRecord
 type errorMsg error
 
 func (m Model) fetchDomains() tea.Msg {
-	domains, err := m.client.ListDomains()
+	domains, err := m.client.ListDomainsContext(m.ctx)
 	if err != nil {
 		return errorMsg(err)
 	}
@@ -180,7 +188,7 @@ func (m Model) fetchDomains() tea.Msg {
 
 func (m Model) fetchRecords(domain string) tea.Cmd {
 	return func() tea.Msg {
-		records, err := m.client.RetrieveRecords(domain)
+		records, err := m.client.RetrieveRecordsContext(m.ctx, domain)
 		if err != nil {
 			return errorMsg(err)
 		}
@@ -0,0 +1,371 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zonefile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"net/netip"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// token is a single field of a master file entry. Quoted strings keep their
+// quoted flag so TXT chunks can be told apart from bare words.
+type token struct {
+	text   string
+	quoted bool
+}
+
+// entry is one logical line of a master file, after joining parenthesized
+// continuations and dropping comments.
+type entry struct {
+	line      int
+	blankName bool // the line started with whitespace, so the owner is inherited
+	tokens    []token
+}
+
+// Parse reads a master file and returns its records relative to origin.
+// $ORIGIN and $TTL directives are honored, owner names may be omitted to
+// repeat the previous one, and TTLs accept BIND unit suffixes such as 1h.
+// SOA records are skipped since Porkbun manages them.
+func Parse(r io.Reader, origin string) ([]Record, error) {
+	entries, err := split(r)
+	if err != nil {
+		return nil, err
+	}
+
+	zone := strings.ToLower(strings.TrimSuffix(origin, "."))
+	current := zone
+	defaultTTL := 600
+	lastOwner := ""
+	var records []Record
+
+	for _, e := range entries {
+		toks := e.tokens
+		switch strings.ToUpper(toks[0].text) {
+		case "$ORIGIN":
+			if len(toks) < 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN needs a name", e.line)
+			}
+			current = absolute(toks[1].text, current)
+			continue
+		case "$TTL":
+			if len(toks) < 2 {
+				return nil, fmt.Errorf("line %d: $TTL needs a value", e.line)
+			}
+			ttl, err := parseTTL(toks[1].text)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %w", e.line, err)
+			}
+			defaultTTL = ttl
+			continue
+		case "$INCLUDE", "$GENERATE":
+			return nil, fmt.Errorf("line %d: %s is not supported", e.line, toks[0].text)
+		}
+
+		owner := lastOwner
+		if !e.blankName {
+			owner = absolute(toks[0].text, current)
+			toks = toks[1:]
+		}
+		if owner == "" {
+			return nil, fmt.Errorf("line %d: record has no owner name", e.line)
+		}
+		if owner != zone && !strings.HasSuffix(owner, "."+zone) {
+			return nil, fmt.Errorf("line %d: %s is outside the %s zone", e.line, owner, zone)
+		}
+		lastOwner = owner
+
+		// TTL and class may appear in either order before the type.
+		ttl := defaultTTL
+		for len(toks) > 0 {
+			t := strings.ToUpper(toks[0].text)
+			if t == "IN" || t == "CH" || t == "HS" {
+				toks = toks[1:]
+				continue
+			}
+			if v, err := parseTTL(t); err == nil {
+				ttl = v
+				toks = toks[1:]
+				continue
+			}
+			break
+		}
+		if len(toks) == 0 {
+			return nil, fmt.Errorf("line %d: missing record type", e.line)
+		}
+		typ := strings.ToUpper(toks[0].text)
+		if typ == "SOA" {
+			continue
+		}
+
+		rec, err := convert(typ, toks[1:], current)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s record: %w", e.line, typ, err)
+		}
+		rec.Name = relative(owner, zone)
+		rec.TTL = ttl
+		records = append(records, rec)
+	}
+	return records, nil
+}
+
+// convert turns RDATA tokens into Porkbun content.
+func convert(typ string, rd []token, origin string) (Record, error) {
+	rec := Record{Type: typ}
+	need := func(n int) error {
+		if len(rd) != n {
+			return fmt.Errorf("expected %d fields, got %d", n, len(rd))
+		}
+		return nil
+	}
+
+	switch typ {
+	case "A", "AAAA":
+		if err := need(1); err != nil {
+			return rec, err
+		}
+		addr, err := netip.ParseAddr(rd[0].text)
+		if err != nil || (typ == "A") != addr.Is4() {
+			return rec, fmt.Errorf("invalid address %q", rd[0].text)
+		}
+		rec.Content = addr.String()
+	case "CNAME", "ALIAS", "NS":
+		if err := need(1); err != nil {
+			return rec, err
+		}
+		rec.Content = absolute(rd[0].text, origin)
+	case "MX":
+		if err := need(2); err != nil {
+			return rec, err
+		}
+		prio, err := strconv.Atoi(rd[0].text)
+		if err != nil {
+			return rec, fmt.Errorf("invalid preference %q", rd[0].text)
+		}
+		rec.Prio = prio
+		rec.Content = target(rd[1].text, origin)
+	case "SRV":
+		if err := need(4); err != nil {
+			return rec, err
+		}
+		prio, err := strconv.Atoi(rd[0].text)
+		if err != nil {
+			return rec, fmt.Errorf("invalid priority %q", rd[0].text)
+		}
+		rec.Prio = prio
+		rec.Content = fmt.Sprintf("%s %s %s", rd[1].text, rd[2].text, target(rd[3].text, origin))
+	case "TXT", "SPF":
+		if len(rd) == 0 {
+			return rec, fmt.Errorf("no text")
+		}
+		// Quoted character-strings are concatenated, which is how long
+		// SPF and DKIM values are split across chunks. Unquoted words
+		// keep the space between them, so v=spf1 -all stays readable.
+		var b strings.Builder
+		for i, t := range rd {
+			if i > 0 && !(t.quoted && rd[i-1].quoted) {
+				b.WriteByte(' ')
+			}
+			b.WriteString(t.text)
+		}
+		rec.Content = b.String()
+	case "CAA":
+		if err := need(3); err != nil {
+			return rec, err
+		}
+		rec.Content = fmt.Sprintf("%s %s %s", rd[0].text, rd[1].text, quote(rd[2].text))
+	default:
+		if len(rd) == 0 {
+			return rec, fmt.Errorf("no data")
+		}
+		parts := make([]string, len(rd))
+		for i, t := range rd {
+			parts[i] = t.text
+			if t.quoted {
+				parts[i] = quote(t.text)
+			}
+		}
+		rec.Content = strings.Join(parts, " ")
+	}
+	return rec, nil
+}
+
+// split tokenizes a master file into logical entries.
+func split(r io.Reader) ([]entry, error) {
+	var entries []entry
+	var cur *entry
+	depth := 0
+
+	sc := bufio.NewScanner(r)
+	sc.Buffer(make([]byte, 64*1024), 1024*1024)
+	lineNo := 0
+	for sc.Scan() {
+		lineNo++
+		line := sc.Text()
+		if depth == 0 {
+			cur = &entry{line: lineNo, blankName: len(line) > 0 && (line[0] == ' ' || line[0] == '\t')}
+		}
+
+		i := 0
+		for i < len(line) {
+			c := line[i]
+			switch {
+			case c == ';':
+				i = len(line)
+			case c == ' ' || c == '\t':
+				i++
+			case c == '(':
+				depth++
+				i++
+			case c == ')':
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced ')'", lineNo)
+				}
+				depth--
+				i++
+			case c == '"':
+				text, n, err := readQuoted(line[i:])
+				if err != nil {
+					return nil, fmt.Errorf("line %d: %w", lineNo, err)
+				}
+				cur.tokens = append(cur.tokens, token{text: text, quoted: true})
+				i += n
+			default:
+				j := i
+				for j < len(line) && !strings.ContainsRune(" \t;()\"", rune(line[j])) {
+					j++
+				}
+				cur.tokens = append(cur.tokens, token{text: line[i:j]})
+				i = j
+			}
+		}
+
+		if depth == 0 && len(cur.tokens) > 0 {
+			entries = append(entries, *cur)
+		}
+	}
+	if err := sc.Err(); err != nil {
+		return nil, err
+	}
+	if depth != 0 {
+		return nil, fmt.Errorf("unbalanced '(' starting on line %d", cur.line)
+	}
+	return entries, nil
+}
+
+// readQuoted reads a quoted character-string starting at s[0] == '"',
+// decoding \X and \DDD escapes. It returns the text and the bytes consumed.
+func readQuoted(s string) (string, int, error) {
+	var b strings.Builder
+	for i := 1; i < len(s); i++ {
+		switch c := s[i]; c {
+		case '"':
+			return b.String(), i + 1, nil
+		case '\\':
+			if i+3 < len(s) && isDigits(s[i+1:i+4]) {
+				v, _ := strconv.Atoi(s[i+1 : i+4])
+				if v > 255 {
+					return "", 0, fmt.Errorf("invalid escape \\%s", s[i+1:i+4])
+				}
+				b.WriteByte(byte(v))
+				i += 3
+			} else if i+1 < len(s) {
+				b.WriteByte(s[i+1])
+				i++
+			}
+		default:
+			b.WriteByte(c)
+		}
+	}
+	return "", 0, fmt.Errorf("unterminated quoted string")
+}
+
+func isDigits(s string) bool {
+	for _, r := range s {
+		if !unicode.IsDigit(r) {
+			return false
+		}
+	}
+	return true
+}
+
+// parseTTL parses a TTL in seconds or with BIND unit suffixes (1h30m, 2d).
+func parseTTL(s string) (int, error) {
+	if v, err := strconv.Atoi(s); err == nil && v >= 0 {
+		return v, nil
+	}
+	units := map[byte]int{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+	total, num := 0, -1
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if c >= '0' && c <= '9' {
+			if num < 0 {
+				num = 0
+			}
+			num = num*10 + int(c-'0')
+			continue
+		}
+		mult, ok := units[byte(unicode.ToLower(rune(c)))]
+		if !ok || num < 0 {
+			return 0, fmt.Errorf("invalid TTL %q", s)
+		}
+		total += num * mult
+		num = -1
+	}
+	if num >= 0 {
+		total += num
+	}
+	if total == 0 && s != "0" {
+		return 0, fmt.Errorf("invalid TTL %q", s)
+	}
+	return total, nil
+}
+
+// absolute resolves a possibly relative name against origin and returns it
+// lower-cased without the trailing dot.
+func absolute(name, origin string) string {
+	if name == "@" {
+		return origin
+	}
+	if strings.HasSuffix(name, ".") {
+		return strings.ToLower(strings.TrimSuffix(name, "."))
+	}
+	if origin == "" {
+		return strings.ToLower(name)
+	}
+	return strings.ToLower(name) + "." + origin
+}
+
+// target resolves a hostname in RDATA like absolute, except that the root
+// (".") is kept as is: it stands for "no target" in a null MX (RFC 7505)
+// and in SRV records.
+func target(name, origin string) string {
+	if name == "." {
+		return name
+	}
+	return absolute(name, origin)
+}
+
+// relative returns name relative to zone, "" for the apex.
+func relative(name, zone string) string {
+	if name == zone {
+		return ""
+	}
+	return strings.TrimSuffix(name, "."+zone)
+}
@@ -0,0 +1,183 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+package zonefile
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		zone string
+		want []Record
+	}{
+		{
+			name: "origin, TTL and relative names",
+			zone: `$ORIGIN example.com.
+$TTL 3600
+@        IN A     192.0.2.1
+www      IN A     192.0.2.2
+         IN AAAA  2001:DB8::1
+mail.example.com. 300 IN A 192.0.2.3
+$ORIGIN dev.example.com.
+api      IN CNAME lb
+`,
+			want: []Record{
+				{Name: "", Type: "A", Content: "192.0.2.1", TTL: 3600},
+				{Name: "www", Type: "A", Content: "192.0.2.2", TTL: 3600},
+				{Name: "www", Type: "AAAA", Content: "2001:db8::1", TTL: 3600},
+				{Name: "mail", Type: "A", Content: "192.0.2.3", TTL: 300},
+				{Name: "api.dev", Type: "CNAME", Content: "lb.dev.example.com", TTL: 3600},
+			},
+		},
+		{
+			name: "TTL units and class order",
+			zone: `$TTL 1h30m
+a 2d IN A 192.0.2.1
+b IN 1W A 192.0.2.2
+c A 192.0.2.3
+`,
+			want: []Record{
+				{Name: "a", Type: "A", Content: "192.0.2.1", TTL: 172800},
+				{Name: "b", Type: "A", Content: "192.0.2.2", TTL: 604800},
+				{Name: "c", Type: "A", Content: "192.0.2.3", TTL: 5400},
+			},
+		},
+		{
+			name: "multi-line SOA and comments",
+			zone: `; exported from somewhere
+@ IN SOA ns1.example.com. hostmaster.example.com. (
+        2024010101 ; serial
+        7200       ; refresh
+        3600 1209600 600 )
+@ IN NS ns1.example.net. ; secondary
+@ IN MX 10 Mail.Example.com.
+`,
+			want: []Record{
+				{Name: "", Type: "NS", Content: "ns1.example.net", TTL: 600},
+				{Name: "", Type: "MX", Content: "mail.example.com", TTL: 600, Prio: 10},
+			},
+		},
+		{
+			name: "TXT",
+			zone: `@ TXT "v=spf1 include:_spf.example.net ~all"
+a TXT "v=DKIM1; k=rsa; " "p=MIIB"
+b TXT v=spf1 -all
+c TXT "say \"hi\"; \226\130\172"
+d TXT ( "one"
+        "two" )
+`,
+			want: []Record{
+				{Name: "", Type: "TXT", Content: "v=spf1 include:_spf.example.net ~all", TTL: 600},
+				{Name: "a", Type: "TXT", Content: "v=DKIM1; k=rsa; p=MIIB", TTL: 600},
+				{Name: "b", Type: "TXT", Content: "v=spf1 -all", TTL: 600},
+				{Name: "c", Type: "TXT", Content: "say \"hi\"; €", TTL: 600},
+				{Name: "d", Type: "TXT", Content: "onetwo", TTL: 600},
+			},
+		},
+		{
+			name: "MX, SRV and CAA",
+			zone: `@ MX 0 .
+_sip._tcp SRV 10 60 5060 sip
+_x._tcp SRV 0 0 0 .
+@ CAA 0 issue "letsencrypt.org"
+@ CAA 0 iodef "mailto:caa\009ops@example.com"
+@ CAA 128 issuewild ca.example.net
+`,
+			want: []Record{
+				{Name: "", Type: "MX", Content: ".", TTL: 600},
+				{Name: "_sip._tcp", Type: "SRV", Content: "60 5060 sip.example.com", TTL: 600, Prio: 10},
+				{Name: "_x._tcp", Type: "SRV", Content: "0 0 .", TTL: 600},
+				{Name: "", Type: "CAA", Content: `0 issue "letsencrypt.org"`, TTL: 600},
+				{Name: "", Type: "CAA", Content: `0 iodef "mailto:caa\009ops@example.com"`, TTL: 600},
+				{Name: "", Type: "CAA", Content: `128 issuewild "ca.example.net"`, TTL: 600},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := Parse(strings.NewReader(tt.zone), "example.com")
+			if err != nil {
+				t.Fatalf("Parse: %v", err)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Parse =\n%+v\nwant\n%+v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		zone    string
+		wantErr string
+	}{
+		{"outside zone", "www.example.net. A 192.0.2.1\n", "outside the example.com zone"},
+		{"no owner", " A 192.0.2.1\n", "no owner name"},
+		{"bad address", "www A 2001:db8::1\n", "invalid address"},
+		{"unbalanced", "@ SOA ns1 host ( 1 2 3\n", "unbalanced '('"},
+		{"unterminated", "@ TXT \"open\n", "unterminated quoted string"},
+		{"include", "$INCLUDE other.zone\n", "not supported"},
+		{"bad TTL", "$TTL 1x\n", "invalid TTL"},
+		{"MX fields", "@ MX mail.example.com.\n", "expected 2 fields"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse(strings.NewReader(tt.zone), "example.com")
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Parse error = %v, want one containing %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestWriteParse(t *testing.T) {
+	records := []Record{
+		{Name: "", Type: "A", Content: "192.0.2.1", TTL: 600},
+		{Name: "", Type: "MX", Content: "mail.example.com", TTL: 600, Prio: 10},
+		{Name: "", Type: "MX", Content: ".", TTL: 600},
+		{Name: "", Type: "TXT", Content: "v=spf1 -all", TTL: 3600},
+		{Name: "", Type: "CAA", Content: `0 issue "letsencrypt.org"`, TTL: 600},
+		{Name: "_sip._tcp", Type: "SRV", Content: "60 5060 sip.example.com", TTL: 600, Prio: 10},
+		{Name: "dkim._domainkey", Type: "TXT", Content: "p=" + strings.Repeat("A", 400), TTL: 600},
+		{Name: "quoted", Type: "TXT", Content: "say \"hi\" \\ café", TTL: 600},
+		{Name: "www", Type: "CNAME", Content: "example.com", TTL: 600},
+		{Name: "lb", Type: "ALIAS", Content: "lb.example.net", TTL: 600},
+	}
+	var b strings.Builder
+	if err := Write(&b, "example.com.", records); err != nil {
+		t.Fatalf("Write: %v", err)
+	}
+	if !strings.Contains(b.String(), "$TTL 600\n") {
+		t.Errorf("Write did not make the most common TTL the default:\n%s", b.String())
+	}
+	if !strings.Contains(b.String(), "; lb ") {
+		t.Errorf("Write did not comment out the ALIAS record:\n%s", b.String())
+	}
+
+	got, err := Parse(strings.NewReader(b.String()), "example.com")
+	if err != nil {
+		t.Fatalf("Parse of written zone: %v\n%s", err, b.String())
+	}
+	// ALIAS records are written as comments, so they don't come back.
+	want := records[:len(records)-1]
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("round trip =\n%+v\nwant\n%+v\nzone:\n%s", got, want, b.String())
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright 2026 Google LLC
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//     http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// Package zonefile reads and writes RFC 1035 master files ("BIND zone
+// files") and converts their records to and from the content format used by
+// the Porkbun API.
+package zonefile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// Record is a resource record in Porkbun's representation: Name is relative
+// to the origin ("" for the apex), MX and SRV priorities live in Prio rather
+// than in Content, and hostnames carry no trailing dot.
+type Record struct {
+	Name    string
+	Type    string
+	Content string
+	TTL     int
+	Prio    int
+}
+
+// maxTXTChunk is the longest character-string a TXT record can hold.
+const maxTXTChunk = 255
+
+// Write renders records as a master file for origin. The most common TTL
+// becomes the $TTL default and is omitted from individual records. ALIAS
+// records aren't standard DNS and other tools reject them, so they are
+// written as comments.
+func Write(w io.Writer, origin string, records []Record) error {
+	origin = strings.TrimSuffix(origin, ".")
+	defaultTTL := commonTTL(records)
+
+	bw := bufio.NewWriter(w)
+	fmt.Fprintf(bw, "$ORIGIN %s.\n", origin)
+	fmt.Fprintf(bw, "$TTL %d\n", defaultTTL)
+	for _, r := range records {
+		owner := r.Name
+		if owner == "" {
+			owner = "@"
+		}
+		ttl := ""
+		if r.TTL != defaultTTL {
+			ttl = fmt.Sprint(r.TTL)
+		}
+		if r.Type == "ALIAS" {
+			fmt.Fprintf(bw, "; %-22s %-6s IN %-6s %s ; provider-specific, not imported by other tools\n", owner, ttl, r.Type, rdata(r))
+			continue
+		}
+		fmt.Fprintf(bw, "%-24s %-6s IN %-6s %s\n", owner, ttl, r.Type, rdata(r))
+	}
+	return bw.Flush()
+}
+
+// rdata converts Porkbun content back to master file RDATA.
+func rdata(r Record) string {
+	switch r.Type {
+	case "CNAME", "ALIAS", "NS":
+		return fqdn(r.Content)
+	case "MX":
+		return fmt.Sprintf("%d %s", r.Prio, fqdn(r.Content))
+	case "SRV":
+		// Porkbun stores "weight port target" with the priority separately.
+		fields := strings.Fields(r.Content)
+		if len(fields) == 3 {
+			fields[2] = fqdn(fields[2])
+		}
+		return fmt.Sprintf("%d %s", r.Prio, strings.Join(fields, " "))
+	case "TXT", "SPF":
+		return quoteTXT(r.Content)
+	}
+	return r.Content
+}
+
+// fqdn makes a hostname absolute by adding the trailing dot.
+func fqdn(name string) string {
+	if name == "" || strings.HasSuffix(name, ".") {
+		return name
+	}
+	return name + "."
+}
+
+// quoteTXT splits text into quoted character-strings of at most 255 bytes.
+func quoteTXT(text string) string {
+	var chunks []string
+	for len(text) > maxTXTChunk {
+		chunks = append(chunks, text[:maxTXTChunk])
+		text = text[maxTXTChunk:]
+	}
+	chunks = append(chunks, text)
+
+	quoted := make([]string, len(chunks))
+	for i, c := range chunks {
+		quoted[i] = quote(c)
+	}
+	return strings.Join(quoted, " ")
+}
+
+// quote renders s as a master file character-string: quotes and
+// backslashes are escaped with a backslash and bytes that aren't printable
+// ASCII become \DDD, the way BIND writes them.
+func quote(s string) string {
+	var b strings.Builder
+	b.WriteByte('"')
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case c == '"' || c == '\\':
+			b.WriteByte('\\')
+			b.WriteByte(c)
+		case c < 0x20 || c > 0x7e:
+			fmt.Fprintf(&b, "\\%03d", c)
+		default:
+			b.WriteByte(c)
+		}
+	}
+	b.WriteByte('"')
+	return b.String()
+}
+
+// commonTTL returns the TTL shared by the most records, preferring the
+// smaller value on ties, or 600 when there are no records.
+func commonTTL(records []Record) int {
+	counts := make(map[int]int)
+	best, bestCount := 600, 0
+	for _, r := range records {
+		counts[r.TTL]++
+	}
+	for ttl, n := range counts {
+		if n > bestCount || (n == bestCount && ttl < best) {
+			best, bestCount = ttl, n
+		}
+	}
+	return best
+}